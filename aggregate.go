@@ -0,0 +1,279 @@
+package mangodex
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+const (
+	MangaAggregatePath = "manga/%s/aggregate"
+
+	// NoneAggregateKey : Volume or chapter key used by the aggregate for chapters without a volume or chapter number.
+	NoneAggregateKey = "none"
+)
+
+// MangaAggregate : The volumes and chapters of a Manga, ordered by volume and chapter number.
+// https://api.mangadex.org/docs.html#operation/get-manga-aggregate
+type MangaAggregate struct {
+	Result  string
+	Volumes []AggregateVolume
+}
+
+func (a *MangaAggregate) GetResult() string {
+	return a.Result
+}
+
+// AggregateVolume : A volume in a MangaAggregate.
+type AggregateVolume struct {
+	// Volume : The volume number, or NoneAggregateKey for chapters without a volume.
+	Volume   string
+	Count    int
+	Chapters []AggregateChapter
+}
+
+// AggregateChapter : A chapter in a MangaAggregate.
+type AggregateChapter struct {
+	// Chapter : The chapter number, or NoneAggregateKey for chapters without a number, such as oneshots.
+	Chapter string `json:"chapter"`
+	// ID : ID of one uploaded Chapter with this number.
+	ID string `json:"id"`
+	// Others : IDs of the other uploaded Chapters with this number, such as uploads by other groups.
+	Others []string `json:"others"`
+	Count  int      `json:"count"`
+}
+
+// IDs : Get the IDs of all uploaded Chapters with this number.
+func (c *AggregateChapter) IDs() []string {
+	return append([]string{c.ID}, c.Others...)
+}
+
+// aggregateVolume : Volume as returned by the API.
+type aggregateVolume struct {
+	Volume   string          `json:"volume"`
+	Count    int             `json:"count"`
+	Chapters json.RawMessage `json:"chapters"`
+}
+
+func (a *MangaAggregate) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Result  string          `json:"result"`
+		Volumes json.RawMessage `json:"volumes"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	a.Result = raw.Result
+	a.Volumes = nil
+
+	volumes, err := unmarshalAggregateVolumes(raw.Volumes)
+	if err != nil {
+		return fmt.Errorf("error unmarshalling aggregate volumes: %s", err.Error())
+	}
+	for key, v := range volumes {
+		chapters, err := unmarshalAggregateChapters(v.Chapters)
+		if err != nil {
+			return fmt.Errorf("error unmarshalling aggregate chapters of volume %s: %s", key, err.Error())
+		}
+
+		vol := AggregateVolume{Volume: v.Volume, Count: v.Count}
+		if vol.Volume == "" {
+			vol.Volume = key
+		}
+		for ckey, c := range chapters {
+			if c.Chapter == "" {
+				c.Chapter = ckey
+			}
+			vol.Chapters = append(vol.Chapters, c)
+		}
+		sort.SliceStable(vol.Chapters, func(i, j int) bool {
+			return lessAggregateKey(vol.Chapters[i].Chapter, vol.Chapters[j].Chapter)
+		})
+		a.Volumes = append(a.Volumes, vol)
+	}
+	sort.SliceStable(a.Volumes, func(i, j int) bool {
+		return lessAggregateKey(a.Volumes[i].Volume, a.Volumes[j].Volume)
+	})
+	return nil
+}
+
+// The API encodes its volume and chapter maps as JSON arrays when their keys are 0 to n-1,
+// such as an empty map, or a volume whose only chapter is "0". Arrays are decoded by keying
+// each element by its volume or chapter number.
+
+// unmarshalAggregateVolumes : Decode the volumes of an aggregate, sent as either an object or an array.
+func unmarshalAggregateVolumes(data json.RawMessage) (map[string]aggregateVolume, error) {
+	volumes := map[string]aggregateVolume{}
+	if isJSONArray(data) {
+		var list []aggregateVolume
+		if err := json.Unmarshal(data, &list); err != nil {
+			return nil, err
+		}
+		for i, v := range list {
+			volumes[aggregateKey(v.Volume, i)] = v
+		}
+		return volumes, nil
+	}
+	if len(data) == 0 || string(data) == "null" {
+		return volumes, nil
+	}
+	return volumes, json.Unmarshal(data, &volumes)
+}
+
+// unmarshalAggregateChapters : Decode the chapters of an aggregate volume, sent as either an object or an array.
+func unmarshalAggregateChapters(data json.RawMessage) (map[string]AggregateChapter, error) {
+	chapters := map[string]AggregateChapter{}
+	if isJSONArray(data) {
+		var list []AggregateChapter
+		if err := json.Unmarshal(data, &list); err != nil {
+			return nil, err
+		}
+		for i, c := range list {
+			chapters[aggregateKey(c.Chapter, i)] = c
+		}
+		return chapters, nil
+	}
+	if len(data) == 0 || string(data) == "null" {
+		return chapters, nil
+	}
+	return chapters, json.Unmarshal(data, &chapters)
+}
+
+// aggregateKey : Get the key of an element of an array-encoded map, which is its index if it has no number.
+func aggregateKey(number string, index int) string {
+	if number != "" {
+		return number
+	}
+	return strconv.Itoa(index)
+}
+
+// isJSONArray : Check if raw JSON is an array.
+func isJSONArray(data json.RawMessage) bool {
+	trimmed := bytes.TrimLeft(data, " \t\r\n")
+	return len(trimmed) > 0 && trimmed[0] == '['
+}
+
+// lessAggregateKey : Order volume and chapter keys numerically.
+// Keys that are not numbers follow in lexical order, and NoneAggregateKey is always last.
+func lessAggregateKey(a, b string) bool {
+	if a == NoneAggregateKey || b == NoneAggregateKey {
+		return b == NoneAggregateKey && a != NoneAggregateKey
+	}
+	fa, errA := strconv.ParseFloat(a, 64)
+	fb, errB := strconv.ParseFloat(b, 64)
+	switch {
+	case errA == nil && errB == nil:
+		return fa < fb
+	case errA == nil || errB == nil:
+		return errA == nil
+	default:
+		return a < b
+	}
+}
+
+// Chapters : Get all chapters in reading order, by chapter number.
+// Chapters with the same number are ordered by volume.
+func (a *MangaAggregate) Chapters() []AggregateChapter {
+	var chapters []AggregateChapter
+	for _, v := range a.Volumes {
+		chapters = append(chapters, v.Chapters...)
+	}
+	sort.SliceStable(chapters, func(i, j int) bool {
+		return lessAggregateKey(chapters[i].Chapter, chapters[j].Chapter)
+	})
+	return chapters
+}
+
+// NextChapter : Get the chapter following the chapter with the given number in reading order.
+// Returns false if the chapter is the last one or does not exist.
+func (a *MangaAggregate) NextChapter(chapter string) (AggregateChapter, bool) {
+	return a.adjacentChapter(chapter, 1)
+}
+
+// PreviousChapter : Get the chapter preceding the chapter with the given number in reading order.
+// Returns false if the chapter is the first one or does not exist.
+func (a *MangaAggregate) PreviousChapter(chapter string) (AggregateChapter, bool) {
+	return a.adjacentChapter(chapter, -1)
+}
+
+// adjacentChapter : Get the chapter offset by step from the chapter with the given number,
+// skipping chapters with the same number in other volumes.
+func (a *MangaAggregate) adjacentChapter(chapter string, step int) (AggregateChapter, bool) {
+	chapters := a.Chapters()
+	for i := range chapters {
+		if chapters[i].Chapter != chapter {
+			continue
+		}
+		for j := i + step; j >= 0 && j < len(chapters); j += step {
+			if chapters[j].Chapter != chapter {
+				return chapters[j], true
+			}
+		}
+		break
+	}
+	return AggregateChapter{}, false
+}
+
+// MissingChapters : Get the whole chapter numbers missing between the first and last numbered chapters.
+// A chapter number counts as present if any chapter has it as its whole part, so 4.5 fills in 4.
+func (a *MangaAggregate) MissingChapters() []string {
+	present := map[int]bool{}
+	first, last := math.MaxInt32, math.MinInt32
+	for _, v := range a.Volumes {
+		for _, c := range v.Chapters {
+			f, err := strconv.ParseFloat(c.Chapter, 64)
+			if err != nil {
+				continue
+			}
+			n := int(math.Floor(f))
+			present[n] = true
+			if n < first {
+				first = n
+			}
+			if n > last {
+				last = n
+			}
+		}
+	}
+
+	var missing []string
+	for n := first; n <= last; n++ {
+		if !present[n] {
+			missing = append(missing, strconv.Itoa(n))
+		}
+	}
+	return missing
+}
+
+// GetAggregate : Get the volumes and chapters of a Manga.
+// Optionally filter by translated languages and scanlation group IDs.
+// https://api.mangadex.org/docs.html#operation/get-manga-aggregate
+func (s *MangaService) GetAggregate(id string, languages, groups []string) (*MangaAggregate, error) {
+	return s.GetAggregateContext(context.Background(), id, languages, groups)
+}
+
+// GetAggregateContext : GetAggregate with custom context.
+func (s *MangaService) GetAggregateContext(ctx context.Context, id string, languages, groups []string) (*MangaAggregate, error) {
+	if err := checkLanguages("translatedLanguage", languages); err != nil {
+		return nil, fmt.Errorf("invalid aggregate query: %s", err.Error())
+	}
+	if err := checkIDs("groups", groups); err != nil {
+		return nil, fmt.Errorf("invalid aggregate query: %s", err.Error())
+	}
+
+	u := s.client.buildURL(fmt.Sprintf(MangaAggregatePath, id))
+
+	q := u.Query()
+	addAll(q, "translatedLanguage[]", languages)
+	addAll(q, "groups[]", groups)
+	u.RawQuery = q.Encode()
+
+	var a MangaAggregate
+	err := s.client.RequestAndDecode(ctx, http.MethodGet, u.String(), nil, &a)
+	return &a, err
+}
@@ -0,0 +1,105 @@
+package mangodex
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const testAggregate = `{"result":"ok","volumes":{
+	"none":{"volume":"none","count":2,"chapters":{
+		"12":{"chapter":"12","id":"c12","others":[],"count":1},
+		"none":{"chapter":"none","id":"oneshot","others":[],"count":1}}},
+	"10":{"volume":"10","count":1,"chapters":{"11":{"chapter":"11","id":"c11","others":[],"count":1}}},
+	"2":{"volume":"2","count":2,"chapters":{
+		"5":{"chapter":"5","id":"c5","others":[],"count":1},
+		"4.5":{"chapter":"4.5","id":"c4.5","others":[],"count":1}}},
+	"1":{"volume":"1","count":3,"chapters":{
+		"2":{"chapter":"2","id":"c2","others":["c2-alt"],"count":2},
+		"1":{"chapter":"1","id":"c1","others":[],"count":1}}}
+}}`
+
+func TestMangaAggregate(t *testing.T) {
+	var a MangaAggregate
+	if err := json.Unmarshal([]byte(testAggregate), &a); err != nil {
+		t.Fatal(err)
+	}
+
+	var order []string
+	for _, v := range a.Volumes {
+		order = append(order, v.Volume)
+	}
+	if strings.Join(order, ",") != "1,2,10,none" {
+		t.Errorf("unexpected volume order %v", order)
+	}
+	order = nil
+	for _, c := range a.Chapters() {
+		order = append(order, c.Chapter)
+	}
+	if strings.Join(order, ",") != "1,2,4.5,5,11,12,none" {
+		t.Errorf("unexpected chapter order %v", order)
+	}
+	if ids := a.Volumes[0].Chapters[1].IDs(); len(ids) != 2 || ids[1] != "c2-alt" {
+		t.Errorf("unexpected IDs %v", ids)
+	}
+
+	if next, ok := a.NextChapter("2"); !ok || next.ID != "c4.5" {
+		t.Errorf("unexpected next chapter %+v", next)
+	}
+	if prev, ok := a.PreviousChapter("11"); !ok || prev.ID != "c5" {
+		t.Errorf("unexpected previous chapter %+v", prev)
+	}
+	if _, ok := a.PreviousChapter("1"); ok {
+		t.Error("expected no chapter before the first")
+	}
+	if missing := a.MissingChapters(); strings.Join(missing, ",") != "3,6,7,8,9,10" {
+		t.Errorf("unexpected missing chapters %v", missing)
+	}
+}
+
+func TestGetAggregate(t *testing.T) {
+	dex := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/manga/"+testUUID+"/aggregate" || r.URL.Query().Get("translatedLanguage[]") != "en" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		_, _ = w.Write([]byte(`{"result":"ok","volumes":[]}`))
+	}))
+
+	a, err := dex.Manga.GetAggregate(testUUID, []string{"en"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(a.Volumes) != 0 || len(a.MissingChapters()) != 0 {
+		t.Errorf("expected an empty aggregate, got %+v", a)
+	}
+	if _, err = dex.Manga.GetAggregate(testUUID, nil, []string{"not-a-uuid"}); err == nil {
+		t.Error("expected invalid group to fail")
+	}
+}
+
+func TestMangaAggregateListEncoding(t *testing.T) {
+	// Maps keyed 0 to n-1 are sent as arrays.
+	var a MangaAggregate
+	err := json.Unmarshal([]byte(`{"result":"ok","volumes":{
+		"1":{"volume":"1","count":1,"chapters":[{"chapter":"0","id":"c0","others":[],"count":1}]},
+		"none":{"volume":"none","count":1,"chapters":{"1":{"chapter":"1","id":"c1","others":[],"count":1}}}
+	}}`), &a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(a.Volumes) != 2 || len(a.Volumes[0].Chapters) != 1 || a.Volumes[0].Chapters[0].ID != "c0" {
+		t.Errorf("unexpected aggregate %+v", a.Volumes)
+	}
+
+	a = MangaAggregate{}
+	err = json.Unmarshal([]byte(`{"result":"ok","volumes":[
+		{"volume":"0","count":1,"chapters":{"none":{"chapter":"none","id":"oneshot","others":[],"count":1}}}
+	]}`), &a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(a.Volumes) != 1 || a.Volumes[0].Volume != "0" || a.Volumes[0].Chapters[0].ID != "oneshot" {
+		t.Errorf("unexpected aggregate %+v", a.Volumes)
+	}
+}
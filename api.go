@@ -5,27 +5,56 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
 )
 
 const (
 	BaseAPI = "https://api.mangadex.org"
+
+	// JSONContentType : Default content type of request bodies.
+	JSONContentType = "application/json"
 )
 
 // DexClient : The MangaDex client.
 type DexClient struct {
-	client *http.Client
-	header http.Header
+	client     *http.Client
+	baseURL    *url.URL
+	reportURL  string
+	uploadsURL string
+	configErr  error // Error from an invalid option, returned by every request.
+
+	common service
 
-	common       service
-	refreshToken string
+	// Header, session state, rate limiter and retry policy, guarded by mu.
+	// refreshMu ensures only one session refresh runs at a time.
+	mu            sync.RWMutex
+	header        http.Header
+	refreshToken  string
+	sessionToken  string
+	sessionExpiry time.Time
+	limiter       RateLimiter
+	retry         *RetryPolicy
+	refreshMu     sync.Mutex
 
 	// Services for MangaDex API
-	Auth    *AuthService
-	Manga   *MangaService
-	Chapter *ChapterService
-	User    *UserService
-	AtHome  *AtHomeService
+	Auth            *AuthService
+	Manga           *MangaService
+	Chapter         *ChapterService
+	User            *UserService
+	AtHome          *AtHomeService
+	Author          *AuthorService
+	ScanlationGroup *ScanlationGroupService
+	Cover           *CoverService
+	CustomList      *CustomListService
+	Statistics      *StatisticsService
+	Rating          *RatingService
+	Legacy          *LegacyService
 }
 
 // service : Wrapper for DexClient.
@@ -34,18 +63,27 @@ type service struct {
 }
 
 // NewDexClient : New anonymous client. To login as an authenticated user, use DexClient.Login.
-func NewDexClient() *DexClient {
+// Options are applied in order, after the defaults.
+func NewDexClient(opts ...Option) *DexClient {
 	// Create client
 	client := http.Client{}
 
-	// Create header
+	// Create header. The content type is set per request.
 	header := http.Header{}
-	header.Set("Content-Type", "application/json") // Set default content type.
 
 	// Create the new client
+	base, _ := url.Parse(BaseAPI)
 	dex := &DexClient{
-		client: &client,
-		header: header,
+		client:     &client,
+		baseURL:    base,
+		reportURL:  MDHomeReportURL,
+		uploadsURL: UploadsURL,
+		limiter:    NewDefaultRateLimiter(),
+		retry:      DefaultRetryPolicy(),
+		header:     header,
+	}
+	for _, opt := range opts {
+		opt(dex)
 	}
 	// Set the common client
 	dex.common.client = dex
@@ -56,43 +94,197 @@ func NewDexClient() *DexClient {
 	dex.Chapter = (*ChapterService)(&dex.common)
 	dex.User = (*UserService)(&dex.common)
 	dex.AtHome = (*AtHomeService)(&dex.common)
+	dex.Author = (*AuthorService)(&dex.common)
+	dex.ScanlationGroup = (*ScanlationGroupService)(&dex.common)
+	dex.Cover = (*CoverService)(&dex.common)
+	dex.CustomList = (*CustomListService)(&dex.common)
+	dex.Statistics = (*StatisticsService)(&dex.common)
+	dex.Rating = (*RatingService)(&dex.common)
+	dex.Legacy = (*LegacyService)(&dex.common)
 
 	return dex
 }
 
+// SetRateLimiter : Set the RateLimiter used for API requests. A nil RateLimiter disables rate limiting.
+func (c *DexClient) SetRateLimiter(rl RateLimiter) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.limiter = rl
+}
+
+// rateLimiter : Get the current RateLimiter.
+func (c *DexClient) rateLimiter() RateLimiter {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.limiter
+}
+
+// SetRetryPolicy : Set the RetryPolicy used for API requests and MangaDex@Home page downloads.
+// A nil RetryPolicy disables retries.
+func (c *DexClient) SetRetryPolicy(p *RetryPolicy) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.retry = p
+}
+
+// retryPolicy : Get the current RetryPolicy.
+func (c *DexClient) retryPolicy() *RetryPolicy {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.retry
+}
+
+// buildURL : Get the URL for an API path, relative to the configured base URL.
+func (c *DexClient) buildURL(p string) *url.URL {
+	u := *c.baseURL
+	u.Path = path.Join("/", u.Path, p)
+	return &u
+}
+
+// route : Get the API path of a request, relative to the configured base URL.
+func (c *DexClient) route(req *http.Request) string {
+	return strings.TrimPrefix(strings.TrimPrefix(req.URL.Path, strings.TrimSuffix(c.baseURL.Path, "/")), "/")
+}
+
 // Request : Sends a request to the MangaDex API.
+// Transient failures are retried according to the client's RetryPolicy.
+// When logged in, the session is refreshed shortly before it expires,
+// and a request failing with 401 is retried once after refreshing the session.
 func (c *DexClient) Request(ctx context.Context, method, url string, body io.Reader) (*http.Response, error) {
+	return c.RequestWithContentType(ctx, method, url, JSONContentType, body)
+}
+
+// RequestWithContentType : Request with a custom content type for the body, such as multipart/form-data.
+func (c *DexClient) RequestWithContentType(ctx context.Context, method, url, contentType string, body io.Reader) (*http.Response, error) {
+	if c.configErr != nil {
+		return nil, c.configErr
+	}
+
 	// Create the request
 	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
 		return nil, err
 	}
+	req.Header.Set("Content-Type", contentType)
 
-	// Set header for request.
-	req.Header = c.header
+	// The session is refreshed at most once per request.
+	autoRefresh := autoRefreshEnabled(ctx)
+	refreshed := false
+	if session, expiring := c.sessionExpiring(); autoRefresh && expiring {
+		refreshed = true
+		// Transient errors are ignored here, as the session may still be valid.
+		if err := c.refreshSession(ctx, session); isRefreshRejected(err) {
+			return nil, err
+		}
+	}
+
+	for attempt, tries := 1, 1; ; attempt++ {
+		resp, err := c.send(req)
+		if err == nil {
+			return resp, nil
+		}
+
+		if autoRefresh && !refreshed && IsUnauthorized(err) && c.Auth.GetRefreshToken() != "" {
+			// Retry once with a fresh session.
+			refreshed = true
+			stale := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+			if rerr := c.refreshSession(ctx, stale); rerr != nil {
+				return nil, withAttempts(err, attempt)
+			}
+		} else {
+			wait, ok := c.retryPolicy().next(method, tries, err)
+			if !ok {
+				return nil, withAttempts(err, attempt)
+			}
+			if serr := sleepContext(ctx, wait); serr != nil {
+				return nil, withAttempts(serr, attempt)
+			}
+			tries++
+		}
+
+		// The body must be sent again, so give up if it cannot be rewound.
+		r, rerr := rewindRequest(req)
+		if rerr != nil {
+			return nil, withAttempts(err, attempt)
+		}
+		req = r
+	}
+}
+
+// send : Send a single request, converting non-200 responses to an APIError.
+func (c *DexClient) send(req *http.Request) (*http.Response, error) {
+	// Set header for request. Each request gets its own copy, as the client header may change concurrently.
+	header := c.headers()
+	if ct := req.Header.Get("Content-Type"); ct != "" {
+		header.Set("Content-Type", ct)
+	}
+	req.Header = header
+
+	// Wait for the rate limiter before sending.
+	route := c.route(req)
+	limiter := c.rateLimiter()
+	if limiter != nil {
+		if err := limiter.Wait(req.Context(), req.Method, route); err != nil {
+			return nil, err
+		}
+	}
 
 	// Send request.
 	resp, err := c.client.Do(req)
 	if err != nil {
 		return nil, err
-	} else if resp.StatusCode != 200 {
-		// Decode to an ErrorResponse struct.
-		var er ErrorResponse
-		if err = json.NewDecoder(resp.Body).Decode(&er); err != nil {
-			return nil, err
-		}
+	}
+	if limiter != nil {
+		limiter.Update(req.Method, route, resp.Header)
+	}
+	if resp.StatusCode != 200 {
+		// Read the body to build an APIError.
 		defer func(Body io.ReadCloser) {
 			_ = Body.Close()
 		}(resp.Body)
-		return nil, fmt.Errorf("non-200 status code -> (%d) %s", resp.StatusCode, er.GetErrors())
+		b, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		return nil, newAPIError(resp, b)
 	}
 	return resp, nil
 }
 
+// headers : Get a copy of the client header.
+func (c *DexClient) headers() http.Header {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.header.Clone()
+}
+
+// rewindRequest : Get a copy of the request that can be sent again.
+// Fails if the request has a body that cannot be re-read.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	r := req.Clone(req.Context())
+	if req.Body == nil || req.Body == http.NoBody {
+		return r, nil
+	}
+	if req.GetBody == nil {
+		return nil, fmt.Errorf("cannot resend request to %s: body is not rewindable", req.URL)
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	r.Body = body
+	return r, nil
+}
+
 // RequestAndDecode : Convenience wrapper to also decode response to required data type
 func (c *DexClient) RequestAndDecode(ctx context.Context, method, url string, body io.Reader, rt ResponseType) error {
+	return c.RequestAndDecodeWithContentType(ctx, method, url, JSONContentType, body, rt)
+}
+
+// RequestAndDecodeWithContentType : RequestAndDecode with a custom content type for the body.
+func (c *DexClient) RequestAndDecodeWithContentType(ctx context.Context, method, url, contentType string, body io.Reader, rt ResponseType) error {
 	// Get the response of the request.
-	resp, err := c.Request(ctx, method, url, body)
+	resp, err := c.RequestWithContentType(ctx, method, url, contentType, body)
 	if err != nil {
 		return err
 	}
@@ -31,7 +31,7 @@ func TestGetMangaList(t *testing.T) {
 	params.Set("limit", strconv.Itoa(100))
 	params.Set("offset", strconv.Itoa(0))
 	// Include Author relationship
-	params.Set("includes[]", AuthorRel)
+	params.Add("includes[]", AuthorRel)
 	// If it is a search, then we add the search term.
 	_, err := client.Manga.GetMangaList(params)
 	if err != nil {
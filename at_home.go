@@ -8,7 +8,6 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
-	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -42,11 +41,13 @@ type ChaptersData struct {
 
 // MDHomeClient : Client for interfacing with MangaDex@Home.
 type MDHomeClient struct {
-	client  *http.Client
-	baseURL string
-	quality string
-	hash    string
-	Pages   []string
+	client    *http.Client
+	retry     *RetryPolicy
+	reportURL string
+	baseURL   string
+	quality   string
+	hash      string
+	Pages     []string
 }
 
 // NewMDHomeClient : Get MangaDex@Home client for a chapter.
@@ -57,8 +58,7 @@ func (s *AtHomeService) NewMDHomeClient(chapterID string, quality string, forceP
 
 // NewMDHomeClientContext : NewMDHomeClient with custom context.
 func (s *AtHomeService) NewMDHomeClientContext(ctx context.Context, chapterID string, quality string, forcePort443 bool) (*MDHomeClient, error) {
-	u, _ := url.Parse(BaseAPI)
-	u.Path = fmt.Sprintf(GetMDHomeURLPath, chapterID)
+	u := s.client.buildURL(fmt.Sprintf(GetMDHomeURLPath, chapterID))
 
 	// Set query parameters
 	q := u.Query()
@@ -78,11 +78,13 @@ func (s *AtHomeService) NewMDHomeClientContext(ctx context.Context, chapterID st
 	}
 
 	return &MDHomeClient{
-		client:  &http.Client{},
-		baseURL: r.BaseURL,
-		quality: quality,
-		hash:    r.Chapter.Hash,
-		Pages:   pages,
+		client:    s.client.client,
+		retry:     s.client.retryPolicy(),
+		reportURL: s.client.reportURL,
+		baseURL:   r.BaseURL,
+		quality:   quality,
+		hash:      r.Chapter.Hash,
+		Pages:     pages,
 	}, nil
 }
 
@@ -92,9 +94,28 @@ func (c *MDHomeClient) GetChapterPage(filename string) ([]byte, error) {
 }
 
 // GetChapterPageWithContext : GetChapterPage with custom context.
+// Transient failures are retried according to the RetryPolicy of the DexClient that created this client.
 func (c *MDHomeClient) GetChapterPageWithContext(ctx context.Context, filename string) ([]byte, error) {
 	path := strings.Join([]string{c.baseURL, c.quality, c.hash, filename}, "/")
 
+	for attempt := 1; ; attempt++ {
+		fileData, err := c.getChapterPage(ctx, path)
+		if err == nil {
+			return fileData, nil
+		}
+
+		wait, ok := c.retry.next(http.MethodGet, attempt, err)
+		if !ok {
+			return nil, withAttempts(err, attempt)
+		}
+		if serr := sleepContext(ctx, wait); serr != nil {
+			return nil, withAttempts(serr, attempt)
+		}
+	}
+}
+
+// getChapterPage : Download a page once, and report the result to MangaDex@Home.
+func (c *MDHomeClient) getChapterPage(ctx context.Context, path string) ([]byte, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -103,36 +124,45 @@ func (c *MDHomeClient) GetChapterPageWithContext(ctx context.Context, filename s
 	// Start timing how long to get all bytes for the file.
 	start := time.Now()
 	resp, err := c.client.Do(req)
+	if err != nil {
+		c.report(ctx, path, start, nil, nil)
+		return nil, err
+	}
 	defer func(Body io.ReadCloser) {
 		_ = Body.Close()
 	}(resp.Body)
 
-	var fileData []byte
-	// If we cannot not get chapter successfully
-	if err != nil || resp.StatusCode != 200 {
-		if err == nil {
-			err = fmt.Errorf("%d status code", resp.StatusCode)
-		}
+	// Read file data.
+	fileData, err := ioutil.ReadAll(resp.Body)
+	if err == nil && resp.StatusCode != 200 {
+		// If we cannot not get chapter successfully
+		err = newAPIError(resp, fileData)
+	}
+	if err != nil {
+		c.report(ctx, path, start, resp, nil)
+		return nil, err
 	}
 
-	// Read file data.
-	fileData, err = ioutil.ReadAll(resp.Body)
+	c.report(ctx, path, start, resp, fileData)
+	return fileData, nil
+}
+
+// report : Send a report of a page download in the background. A nil fileData reports a failure.
+func (c *MDHomeClient) report(ctx context.Context, path string, start time.Time, resp *http.Response, fileData []byte) {
+	// Create the payload to send.
+	r := &reportPayload{
+		URL:      path,
+		Success:  fileData != nil,
+		Bytes:    len(fileData),
+		Duration: time.Since(start).Milliseconds(),
+		Cached:   resp != nil && strings.HasPrefix(resp.Header.Get("X-Cache"), "HIT"),
+	}
 
-	// Send report in the background.
 	go func() {
-		// Create the payload to send.
-		r := &reportPayload{
-			URL:      path,
-			Success:  err == nil,
-			Bytes:    len(fileData),
-			Duration: time.Since(start).Milliseconds(),
-			Cached:   strings.HasPrefix(resp.Header.Get("X-Cache"), "HIT"),
+		if resp, err := c.reportContext(ctx, r); err == nil { // Send report
+			_ = resp.Body.Close()
 		}
-
-		_, _ = c.reportContext(ctx, r) // Send report
 	}()
-
-	return fileData, err
 }
 
 // reportPayload : Required fields for reporting page download result.
@@ -150,7 +180,7 @@ func (c *MDHomeClient) reportContext(ctx context.Context, r *reportPayload) (*ht
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, MDHomeReportURL, bytes.NewBuffer(rBytes))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.reportURL, bytes.NewBuffer(rBytes))
 	if err != nil {
 		return nil, err
 	}
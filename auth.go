@@ -3,10 +3,12 @@ package mangodex
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"net/url"
+	"strings"
+	"time"
 )
 
 const (
@@ -15,6 +17,9 @@ const (
 	RefreshTokenPath = "auth/refresh"
 )
 
+// sessionRefreshLeeway : How long before the session token expires it is proactively refreshed.
+const sessionRefreshLeeway = time.Minute
+
 // AuthService : Provides Auth services provided by the API.
 type AuthService service
 
@@ -43,8 +48,7 @@ func (s *AuthService) Login(user, pwd string) error {
 
 // LoginContext : Login with custom context.
 func (s *AuthService) LoginContext(ctx context.Context, user, pwd string) error {
-	u, _ := url.Parse(BaseAPI)
-	u.Path = LoginPath
+	u := s.client.buildURL(LoginPath)
 
 	// Create required request body.
 	req := map[string]string{
@@ -57,13 +61,13 @@ func (s *AuthService) LoginContext(ctx context.Context, user, pwd string) error
 	}
 
 	var ar AuthResponse
-	if err = s.client.RequestAndDecode(ctx, http.MethodPost, u.String(), bytes.NewBuffer(rBytes), &ar); err != nil {
+	err = s.client.RequestAndDecode(withoutAutoRefresh(ctx), http.MethodPost, u.String(), bytes.NewBuffer(rBytes), &ar)
+	if err != nil {
 		return err
 	}
 
-	// Set client token and header for authorization.
-	s.SetRefreshToken(ar.Token.Refresh)
-	s.client.header.Set("Authorization", fmt.Sprintf("Bearer %s", ar.Token.Session))
+	// Set client tokens and header for authorization.
+	s.client.setSession(ar.Token.Session, ar.Token.Refresh)
 	return nil
 }
 
@@ -75,17 +79,15 @@ func (s *AuthService) Logout() error {
 
 // LogoutContext : Logout with custom context.
 func (s *AuthService) LogoutContext(ctx context.Context) error {
-	u, _ := url.Parse(BaseAPI)
-	u.Path = LogoutPath
+	u := s.client.buildURL(LogoutPath)
 
 	var r Response
-	if err := s.client.RequestAndDecode(ctx, http.MethodPost, u.String(), nil, &r); err != nil {
+	if err := s.client.RequestAndDecode(withoutAutoRefresh(ctx), http.MethodPost, u.String(), nil, &r); err != nil {
 		return err
 	}
 
-	// Remove the stored client token and also authorization header.
-	s.SetRefreshToken("")
-	s.client.header.Del("Authorization")
+	// Remove the stored client tokens and also authorization header.
+	s.client.setSession("", "")
 	return nil
 }
 
@@ -97,12 +99,11 @@ func (s *AuthService) RefreshSessionToken() error {
 
 // RefreshSessionTokenContext : refreshToken with custom context.
 func (s *AuthService) RefreshSessionTokenContext(ctx context.Context) error {
-	u, _ := url.Parse(BaseAPI)
-	u.Path = RefreshTokenPath
+	u := s.client.buildURL(RefreshTokenPath)
 
 	// Create required request body.
 	req := map[string]string{
-		"token": s.client.refreshToken,
+		"token": s.GetRefreshToken(),
 	}
 	rBytes, err := json.Marshal(&req)
 	if err != nil {
@@ -110,27 +111,137 @@ func (s *AuthService) RefreshSessionTokenContext(ctx context.Context) error {
 	}
 
 	var ar AuthResponse
-	if err = s.client.RequestAndDecode(ctx, http.MethodPost, u.String(), bytes.NewBuffer(rBytes), &ar); err != nil {
+	err = s.client.RequestAndDecode(withoutAutoRefresh(ctx), http.MethodPost, u.String(), bytes.NewBuffer(rBytes), &ar)
+	if err != nil {
 		return err
 	}
 
 	// Update tokens
-	s.SetRefreshToken(ar.Token.Refresh)
-	s.client.header.Set("Authorization", fmt.Sprintf("Bearer %s", ar.Token.Session))
+	s.client.setSession(ar.Token.Session, ar.Token.Refresh)
 	return nil
 }
 
 // IsLoggedIn : Return true when client logged in and false otherwise.
 func (s *AuthService) IsLoggedIn() bool {
+	s.client.mu.RLock()
+	defer s.client.mu.RUnlock()
 	return s.client.header.Get("Authorization") != ""
 }
 
 // GetRefreshToken : Get the current refresh token of the client.
 func (s *AuthService) GetRefreshToken() string {
+	s.client.mu.RLock()
+	defer s.client.mu.RUnlock()
 	return s.client.refreshToken
 }
 
 // SetRefreshToken : Set the refresh token for the client.
 func (s *AuthService) SetRefreshToken(refreshToken string) {
+	s.client.mu.Lock()
+	defer s.client.mu.Unlock()
 	s.client.refreshToken = refreshToken
 }
+
+// GetSessionExpiry : Get the time the current session token expires.
+// Returns the zero time if not logged in, or if the expiry could not be decoded from the token.
+func (s *AuthService) GetSessionExpiry() time.Time {
+	s.client.mu.RLock()
+	defer s.client.mu.RUnlock()
+	return s.client.sessionExpiry
+}
+
+// setSession : Store the session and refresh tokens, and set the authorization header.
+// Empty tokens clear the session.
+func (c *DexClient) setSession(session, refresh string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.sessionToken = session
+	c.refreshToken = refresh
+	c.sessionExpiry = time.Time{}
+	if session == "" {
+		c.header.Del("Authorization")
+		return
+	}
+	if exp, err := parseTokenExpiry(session); err == nil {
+		c.sessionExpiry = exp
+	}
+	c.header.Set("Authorization", fmt.Sprintf("Bearer %s", session))
+}
+
+// sessionExpiring : Check if the session token is about to expire and can be refreshed.
+// Also returns the current session token.
+func (c *DexClient) sessionExpiring() (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.sessionToken == "" || c.refreshToken == "" || c.sessionExpiry.IsZero() {
+		return c.sessionToken, false
+	}
+	return c.sessionToken, time.Until(c.sessionExpiry) < sessionRefreshLeeway
+}
+
+// refreshSession : Refresh the session, unless it has already been refreshed since the stale token was used.
+// Concurrent callers holding the same stale token result in a single refresh.
+// If the API rejects the refresh token, the session is cleared so that later requests do not retry the refresh.
+func (c *DexClient) refreshSession(ctx context.Context, stale string) error {
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+
+	c.mu.RLock()
+	current := c.sessionToken
+	c.mu.RUnlock()
+	if current != stale {
+		return nil // Another request already refreshed or cleared the session.
+	}
+
+	err := c.Auth.RefreshSessionTokenContext(ctx)
+	if isRefreshRejected(err) {
+		c.setSession("", "")
+	}
+	return err
+}
+
+// isRefreshRejected : Check if a refresh failed because the refresh token is invalid or expired,
+// rather than due to a transient error.
+func isRefreshRejected(err error) bool {
+	return IsUnauthorized(err) || IsForbidden(err) || IsValidation(err)
+}
+
+// parseTokenExpiry : Decode the expiry time from the claims of a JWT.
+func parseTokenExpiry(token string) (time.Time, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, fmt.Errorf("malformed token: expected 3 parts, got %d", len(parts))
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error decoding token payload: %s", err.Error())
+	}
+
+	var claims struct {
+		Exp float64 `json:"exp"`
+	}
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return time.Time{}, fmt.Errorf("error unmarshalling token claims: %s", err.Error())
+	}
+	if claims.Exp == 0 {
+		return time.Time{}, fmt.Errorf("token has no expiry")
+	}
+	return time.Unix(int64(claims.Exp), 0), nil
+}
+
+// autoRefreshKey : Context key to disable automatic session refreshes.
+type autoRefreshKey struct{}
+
+// withoutAutoRefresh : Context for requests that must not trigger a session refresh, such as the auth requests.
+func withoutAutoRefresh(ctx context.Context) context.Context {
+	return context.WithValue(ctx, autoRefreshKey{}, false)
+}
+
+// autoRefreshEnabled : Check if requests with this context may refresh the session.
+func autoRefreshEnabled(ctx context.Context) bool {
+	enabled, ok := ctx.Value(autoRefreshKey{}).(bool)
+	return !ok || enabled
+}
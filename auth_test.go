@@ -0,0 +1,190 @@
+package mangodex
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// authHandler : Fake auth endpoints. Sessions issued after a refresh are valid, the login session is not.
+type authHandler struct {
+	loginExpiry time.Time
+	refreshes   int32
+}
+
+func (h *authHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/" + LoginPath:
+		fmt.Fprintf(w, `{"result":"ok","token":{"session":%q,"refresh":"refresh"}}`, fakeJWT("login", h.loginExpiry))
+	case "/" + RefreshTokenPath:
+		n := atomic.AddInt32(&h.refreshes, 1)
+		session := fakeJWT(fmt.Sprintf("refreshed-%d", n), time.Now().Add(15*time.Minute))
+		fmt.Fprintf(w, `{"result":"ok","token":{"session":%q,"refresh":"refresh"}}`, session)
+	default:
+		if r.Header.Get("Authorization") == "Bearer "+fakeJWT("login", h.loginExpiry) {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"result":"error","errors":[{"status":401,"title":"unauthorized"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"result":"ok"}`))
+	}
+}
+
+func TestRefreshOnUnauthorized(t *testing.T) {
+	h := &authHandler{loginExpiry: time.Now().Add(time.Hour)}
+	dex := newTestClient(t, h)
+	if err := dex.Auth.Login("user", "pwd"); err != nil {
+		t.Fatal(err)
+	}
+	if exp := dex.Auth.GetSessionExpiry(); exp.Unix() != h.loginExpiry.Unix() {
+		t.Errorf("expected session expiry %v, got %v", h.loginExpiry, exp)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := dex.User.GetLoggedUser(); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&h.refreshes); n != 1 {
+		t.Errorf("expected a single refresh, got %d", n)
+	}
+}
+
+func TestProactiveRefresh(t *testing.T) {
+	h := &authHandler{loginExpiry: time.Now().Add(10 * time.Second)}
+	dex := newTestClient(t, h)
+	if err := dex.Auth.Login("user", "pwd"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := dex.User.GetLoggedUser(); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&h.refreshes); n != 1 {
+		t.Errorf("expected session to be refreshed before the request, got %d refreshes", n)
+	}
+	if time.Until(dex.Auth.GetSessionExpiry()) < 10*time.Minute {
+		t.Errorf("session expiry not updated: %v", dex.Auth.GetSessionExpiry())
+	}
+}
+
+// TestConcurrentAuthState : Run with -race to check the auth state is safe for concurrent use.
+func TestConcurrentAuthState(t *testing.T) {
+	dex := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/" + LoginPath, "/" + RefreshTokenPath:
+			session := fakeJWT("user", time.Now().Add(15*time.Minute))
+			fmt.Fprintf(w, `{"result":"ok","token":{"session":%q,"refresh":"refresh"}}`, session)
+		default:
+			_, _ = w.Write([]byte(`{"result":"ok"}`))
+		}
+	}))
+
+	var wg sync.WaitGroup
+	run := func(n int, f func() error) {
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := f(); err != nil {
+					t.Error(err)
+				}
+			}()
+		}
+	}
+
+	run(50, func() error {
+		_, err := dex.User.GetLoggedUser()
+		return err
+	})
+	run(5, func() error { return dex.Auth.Login("user", "pwd") })
+	run(5, dex.Auth.RefreshSessionToken)
+	run(5, dex.Auth.Logout)
+	run(20, func() error {
+		_ = dex.Auth.IsLoggedIn()
+		_ = dex.Auth.GetSessionExpiry()
+		dex.Auth.SetRefreshToken(dex.Auth.GetRefreshToken())
+		return nil
+	})
+	wg.Wait()
+}
+
+func TestRejectedRefresh(t *testing.T) {
+	var refreshes int32
+	dex := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/" + LoginPath:
+			session := fakeJWT("user", time.Now().Add(10*time.Second))
+			fmt.Fprintf(w, `{"result":"ok","token":{"session":%q,"refresh":"refresh"}}`, session)
+		case "/" + RefreshTokenPath:
+			atomic.AddInt32(&refreshes, 1)
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"result":"error","errors":[{"status":401,"title":"unauthorized"}]}`))
+		default:
+			if r.Header.Get("Authorization") != "" {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"result":"error","errors":[{"status":401,"title":"unauthorized"}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"result":"ok"}`))
+		}
+	}))
+	if err := dex.Auth.Login("user", "pwd"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := dex.User.GetLoggedUser(); !IsUnauthorized(err) {
+		t.Errorf("expected the rejected refresh to be returned, got %v", err)
+	}
+	if dex.Auth.IsLoggedIn() || dex.Auth.GetRefreshToken() != "" {
+		t.Error("expected the session to be cleared")
+	}
+	for i := 0; i < 4; i++ {
+		if _, err := dex.User.GetLoggedUser(); err != nil {
+			t.Error(err)
+		}
+	}
+	if n := atomic.LoadInt32(&refreshes); n != 1 {
+		t.Errorf("expected a single refresh attempt, got %d", n)
+	}
+}
+
+func TestRejectedRefreshOnUnauthorized(t *testing.T) {
+	var refreshes int32
+	dex := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/" + LoginPath:
+			session := fakeJWT("user", time.Now().Add(time.Hour))
+			fmt.Fprintf(w, `{"result":"ok","token":{"session":%q,"refresh":"refresh"}}`, session)
+		case "/" + RefreshTokenPath:
+			atomic.AddInt32(&refreshes, 1)
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"result":"error","errors":[{"status":400,"title":"validation_exception"}]}`))
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"result":"error","errors":[{"status":401,"title":"unauthorized"}]}`))
+		}
+	}))
+	if err := dex.Auth.Login("user", "pwd"); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 5; i++ {
+		if _, err := dex.User.GetLoggedUser(); !IsUnauthorized(err) {
+			t.Errorf("expected 401, got %v", err)
+		}
+	}
+	if n := atomic.LoadInt32(&refreshes); n != 1 {
+		t.Errorf("expected a single refresh attempt, got %d", n)
+	}
+}
@@ -1,5 +1,74 @@
 package mangodex
 
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	AuthorListPath = "author"
+	AuthorPath     = "author/%s"
+)
+
+// AuthorService : Provides Author services provided by the API.
+type AuthorService service
+
+// AuthorResponse : A response for getting a single Author.
+type AuthorResponse struct {
+	Result   string `json:"result"`
+	Response string `json:"response"`
+	Data     Author `json:"data"`
+}
+
+func (ar *AuthorResponse) GetResult() string {
+	return ar.Result
+}
+
+// AuthorList : A response for getting a list of authors.
+type AuthorList struct {
+	Result   string   `json:"result"`
+	Response string   `json:"response"`
+	Data     []Author `json:"data"`
+	Limit    int      `json:"limit"`
+	Offset   int      `json:"offset"`
+	Total    int      `json:"total"`
+}
+
+func (al *AuthorList) GetResult() string {
+	return al.Result
+}
+
+// Author : Struct containing information on an Author or Artist.
+type Author struct {
+	ID            string           `json:"id"`
+	Type          string           `json:"type"`
+	Attributes    AuthorAttributes `json:"attributes"`
+	Relationships []Relationship   `json:"relationships"`
+}
+
+// relationshipAuthors : Get the authors among relationships of a type, AuthorRel or ArtistRel.
+// Returns false if any of them was not expanded.
+func relationshipAuthors(rels []Relationship, typ string) ([]Author, bool) {
+	var authors []Author
+	expanded := true
+	for _, rel := range rels {
+		if rel.Type != typ {
+			continue
+		}
+		a := Author{ID: rel.ID, Type: AuthorRel}
+		if attrs, ok := rel.AsAuthor(); ok {
+			a.Attributes = *attrs
+		} else {
+			expanded = false
+		}
+		authors = append(authors, a)
+	}
+	return authors, expanded
+}
+
 // AuthorAttributes : Attributes for an Author.
 type AuthorAttributes struct {
 	Name      string           `json:"name"`
@@ -9,3 +78,151 @@ type AuthorAttributes struct {
 	CreatedAt string           `json:"createdAt"`
 	UpdatedAt string           `json:"updatedAt"`
 }
+
+// GetAuthor : Get an Author by ID.
+// https://api.mangadex.org/docs.html#operation/get-author-id
+func (s *AuthorService) GetAuthor(id string, includes []string) (*AuthorResponse, error) {
+	return s.GetAuthorContext(context.Background(), id, includes)
+}
+
+// GetAuthorContext : GetAuthor with custom context.
+func (s *AuthorService) GetAuthorContext(ctx context.Context, id string, includes []string) (*AuthorResponse, error) {
+	u := s.client.buildURL(fmt.Sprintf(AuthorPath, id))
+
+	// Set query parameters
+	q := u.Query()
+	for _, i := range includes {
+		q.Add("includes[]", i)
+	}
+	u.RawQuery = q.Encode()
+
+	var r AuthorResponse
+	err := s.client.RequestAndDecode(ctx, http.MethodGet, u.String(), nil, &r)
+	return &r, err
+}
+
+// SearchAuthors : Search for Authors.
+// https://api.mangadex.org/docs.html#operation/get-author
+func (s *AuthorService) SearchAuthors(q *AuthorSearchQuery) (*AuthorList, error) {
+	return s.SearchAuthorsContext(context.Background(), q)
+}
+
+// SearchAuthorsContext : SearchAuthors with custom context.
+func (s *AuthorService) SearchAuthorsContext(ctx context.Context, q *AuthorSearchQuery) (*AuthorList, error) {
+	params, err := q.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	u := s.client.buildURL(AuthorListPath)
+	u.RawQuery = params.Encode()
+
+	var l AuthorList
+	err = s.client.RequestAndDecode(ctx, http.MethodGet, u.String(), nil, &l)
+	return &l, err
+}
+
+// AuthorEdit : Fields for creating or updating an Author.
+type AuthorEdit struct {
+	Name      string            `json:"name"`
+	Biography map[string]string `json:"biography,omitempty"`
+	// Version : Current version of the Author, required for updates.
+	// The update fails if the Author was modified since this version.
+	Version int `json:"version,omitempty"`
+}
+
+// CreateAuthor : Create an Author.
+// https://api.mangadex.org/docs.html#operation/post-author
+func (s *AuthorService) CreateAuthor(edit AuthorEdit) (*AuthorResponse, error) {
+	return s.CreateAuthorContext(context.Background(), edit)
+}
+
+// CreateAuthorContext : CreateAuthor with custom context.
+func (s *AuthorService) CreateAuthorContext(ctx context.Context, edit AuthorEdit) (*AuthorResponse, error) {
+	u := s.client.buildURL(AuthorListPath)
+
+	// A new Author has no version.
+	edit.Version = 0
+	rBytes, err := json.Marshal(&edit)
+	if err != nil {
+		return nil, err
+	}
+
+	var r AuthorResponse
+	err = s.client.RequestAndDecode(ctx, http.MethodPost, u.String(), bytes.NewBuffer(rBytes), &r)
+	return &r, err
+}
+
+// UpdateAuthor : Update an Author. The edit must contain the current version of the Author.
+// https://api.mangadex.org/docs.html#operation/put-author-id
+func (s *AuthorService) UpdateAuthor(id string, edit AuthorEdit) (*AuthorResponse, error) {
+	return s.UpdateAuthorContext(context.Background(), id, edit)
+}
+
+// UpdateAuthorContext : UpdateAuthor with custom context.
+func (s *AuthorService) UpdateAuthorContext(ctx context.Context, id string, edit AuthorEdit) (*AuthorResponse, error) {
+	if edit.Version < 1 {
+		return nil, fmt.Errorf("updating author %s requires its current version", id)
+	}
+	u := s.client.buildURL(fmt.Sprintf(AuthorPath, id))
+
+	rBytes, err := json.Marshal(&edit)
+	if err != nil {
+		return nil, err
+	}
+
+	var r AuthorResponse
+	err = s.client.RequestAndDecode(ctx, http.MethodPut, u.String(), bytes.NewBuffer(rBytes), &r)
+	return &r, err
+}
+
+// DeleteAuthor : Delete an Author.
+// https://api.mangadex.org/docs.html#operation/delete-author-id
+func (s *AuthorService) DeleteAuthor(id string) (*Response, error) {
+	return s.DeleteAuthorContext(context.Background(), id)
+}
+
+// DeleteAuthorContext : DeleteAuthor with custom context.
+func (s *AuthorService) DeleteAuthorContext(ctx context.Context, id string) (*Response, error) {
+	u := s.client.buildURL(fmt.Sprintf(AuthorPath, id))
+
+	var r Response
+	err := s.client.RequestAndDecode(ctx, http.MethodDelete, u.String(), nil, &r)
+	return &r, err
+}
+
+// GetAuthorWorks : Get all Manga an Author worked on, either as author or artist, of any content rating.
+func (s *AuthorService) GetAuthorWorks(id string, includes []string) ([]Manga, error) {
+	return s.GetAuthorWorksContext(context.Background(), id, includes)
+}
+
+// GetAuthorWorksContext : GetAuthorWorks with custom context.
+func (s *AuthorService) GetAuthorWorksContext(ctx context.Context, id string, includes []string) ([]Manga, error) {
+	// Request every content rating, as the API otherwise leaves out pornographic Manga.
+	ratings := []string{Safe, Suggestive, Erotica, Porn}
+	queries := []*MangaSearchQuery{
+		{Authors: []string{id}, Includes: includes, ContentRating: ratings},
+		{Artists: []string{id}, Includes: includes, ContentRating: ratings},
+	}
+
+	var works []Manga
+	seen := map[string]bool{}
+	for _, q := range queries {
+		params, err := q.Values()
+		if err != nil {
+			return nil, err
+		}
+
+		it := s.client.Manga.IterateMangaList(params)
+		for it.Next(ctx) {
+			if m := it.Value(); !seen[m.ID] {
+				seen[m.ID] = true
+				works = append(works, *m)
+			}
+		}
+		if err = it.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return works, nil
+}
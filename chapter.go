@@ -10,6 +10,8 @@ import (
 )
 
 const (
+	ChapterListPath      = "chapter"
+	ChapterPath          = "chapter/%s"
 	MangaChaptersPath    = "manga/%s/feed"
 	MangaReadMarkersPath = "manga/%s/read"
 )
@@ -31,6 +33,17 @@ func (cl *ChapterList) GetResult() string {
 	return cl.Result
 }
 
+// ChapterResponse : A response for getting a single Chapter.
+type ChapterResponse struct {
+	Result   string  `json:"result"`
+	Response string  `json:"response"`
+	Data     Chapter `json:"data"`
+}
+
+func (cr *ChapterResponse) GetResult() string {
+	return cr.Result
+}
+
 // Chapter : Struct containing information on a manga.
 type Chapter struct {
 	ID            string            `json:"id"`
@@ -39,6 +52,54 @@ type Chapter struct {
 	Relationships []Relationship    `json:"relationships"`
 }
 
+// Manga : Get the Manga of the Chapter, or nil if it has none.
+// Returns false if the Manga was not expanded with includes[]=manga, in which case only its ID is set.
+func (c *Chapter) Manga() (*Manga, bool) {
+	for _, rel := range c.Relationships {
+		if rel.Type == MangaRel {
+			m := &Manga{ID: rel.ID, Type: MangaRel}
+			attrs, ok := rel.AsManga()
+			if ok {
+				m.Attributes = *attrs
+			}
+			return m, ok
+		}
+	}
+	return nil, false
+}
+
+// ScanlationGroups : Get the scanlation groups of the Chapter.
+// Returns false if any group was not expanded with includes[]=scanlation_group, in which case only its ID is set.
+func (c *Chapter) ScanlationGroups() ([]ScanlationGroup, bool) {
+	var groups []ScanlationGroup
+	expanded := true
+	for _, rel := range c.Relationships {
+		if rel.Type != ScanlationGroupRel {
+			continue
+		}
+		g := ScanlationGroup{ID: rel.ID, Type: ScanlationGroupRel}
+		if attrs, ok := rel.AsScanlationGroup(); ok {
+			g.Attributes = *attrs
+		} else {
+			expanded = false
+		}
+		groups = append(groups, g)
+	}
+	return groups, expanded
+}
+
+// Uploader : Get the user that uploaded the Chapter, or nil if unknown.
+// Returns false if the user was not expanded with includes[]=user, in which case only its ID is set.
+func (c *Chapter) Uploader() (*User, bool) {
+	for _, rel := range c.Relationships {
+		if rel.Type == UserRel {
+			u, ok := relationshipUser(rel)
+			return &u, ok
+		}
+	}
+	return nil, false
+}
+
 // GetTitle : Get a title for the chapter.
 func (c *Chapter) GetTitle() string {
 	return c.Attributes.Title
@@ -66,6 +127,28 @@ type ChapterAttributes struct {
 	PublishAt          string  `json:"publishAt"`
 }
 
+// GetChapter : Get a Chapter by ID.
+// https://api.mangadex.org/docs.html#operation/get-chapter-id
+func (s *ChapterService) GetChapter(id string, includes []string) (*ChapterResponse, error) {
+	return s.GetChapterContext(context.Background(), id, includes)
+}
+
+// GetChapterContext : GetChapter with custom context.
+func (s *ChapterService) GetChapterContext(ctx context.Context, id string, includes []string) (*ChapterResponse, error) {
+	u := s.client.buildURL(fmt.Sprintf(ChapterPath, id))
+
+	// Set query parameters
+	q := u.Query()
+	for _, i := range includes {
+		q.Add("includes[]", i)
+	}
+	u.RawQuery = q.Encode()
+
+	var r ChapterResponse
+	err := s.client.RequestAndDecode(ctx, http.MethodGet, u.String(), nil, &r)
+	return &r, err
+}
+
 // GetMangaChapters : Get a list of chapters for a manga.
 // https://api.mangadex.org/docs.html#operation/get-manga-id-feed
 func (s *ChapterService) GetMangaChapters(id string, params url.Values) (*ChapterList, error) {
@@ -74,8 +157,7 @@ func (s *ChapterService) GetMangaChapters(id string, params url.Values) (*Chapte
 
 // GetMangaChaptersContext : GetMangaChapters with custom context.
 func (s *ChapterService) GetMangaChaptersContext(ctx context.Context, id string, params url.Values) (*ChapterList, error) {
-	u, _ := url.Parse(BaseAPI)
-	u.Path = fmt.Sprintf(MangaChaptersPath, id)
+	u := s.client.buildURL(fmt.Sprintf(MangaChaptersPath, id))
 
 	// Set request parameters
 	u.RawQuery = params.Encode()
@@ -85,6 +167,29 @@ func (s *ChapterService) GetMangaChaptersContext(ctx context.Context, id string,
 	return &l, err
 }
 
+// GetMangaFeed : Get a list of chapters for a manga, using a typed feed query.
+// https://api.mangadex.org/docs.html#operation/get-manga-id-feed
+func (s *ChapterService) GetMangaFeed(id string, q *ChapterFeedQuery) (*ChapterList, error) {
+	return s.GetMangaFeedContext(context.Background(), id, q)
+}
+
+// GetMangaFeedContext : GetMangaFeed with custom context.
+func (s *ChapterService) GetMangaFeedContext(ctx context.Context, id string, q *ChapterFeedQuery) (*ChapterList, error) {
+	params, err := q.Values()
+	if err != nil {
+		return nil, err
+	}
+	return s.GetMangaChaptersContext(ctx, id, params)
+}
+
+// IterateMangaChapters : Get a ChapterIterator over all chapters of a manga matching the parameters.
+// Pages are fetched lazily using GetMangaChaptersContext.
+func (s *ChapterService) IterateMangaChapters(id string, params url.Values) *ChapterIterator {
+	return newChapterIterator(params, func(ctx context.Context, params url.Values) (*ChapterList, error) {
+		return s.GetMangaChaptersContext(ctx, id, params)
+	})
+}
+
 // ChapterReadMarkers : A response for getting a list of read chapters.
 type ChapterReadMarkers struct {
 	Result string   `json:"result"`
@@ -103,8 +208,7 @@ func (s *ChapterService) GetReadMangaChapters(id string) (*ChapterReadMarkers, e
 
 // GetReadMangaChaptersContext : GetReadMangaChapters with custom context.
 func (s *ChapterService) GetReadMangaChaptersContext(ctx context.Context, id string) (*ChapterReadMarkers, error) {
-	u, _ := url.Parse(BaseAPI)
-	u.Path = fmt.Sprintf(MangaReadMarkersPath, id)
+	u := s.client.buildURL(fmt.Sprintf(MangaReadMarkersPath, id))
 
 	var rmr ChapterReadMarkers
 	err := s.client.RequestAndDecode(ctx, http.MethodGet, u.String(), nil, &rmr)
@@ -118,8 +222,7 @@ func (s *ChapterService) SetReadUnreadMangaChapters(id string, read, unRead []st
 
 // SetReadUnreadMangaChaptersContext : SetReadUnreadMangaChapters with custom context.
 func (s *ChapterService) SetReadUnreadMangaChaptersContext(ctx context.Context, id string, read, unRead []string) (*Response, error) {
-	u, _ := url.Parse(BaseAPI)
-	u.Path = fmt.Sprintf(MangaReadMarkersPath, id)
+	u := s.client.buildURL(fmt.Sprintf(MangaReadMarkersPath, id))
 
 	// Set request body.
 	req := map[string][]string{
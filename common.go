@@ -21,9 +21,13 @@ func (r *Response) GetResult() string {
 }
 
 // Relationship : Struct containing relationships, with optional attributes for the relation.
+// Attributes are nil unless the relationship was expanded with includes[]. Expanded attributes are
+// decoded to the type registered with RegisterRelationshipType, or json.RawMessage for unknown types.
 type Relationship struct {
-	ID         string      `json:"id"`
-	Type       string      `json:"type"`
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	// Related : How a related Manga relates to the Manga, such as "sequel". Only set for manga relationships of a Manga.
+	Related    string      `json:"related,omitempty"`
 	Attributes interface{} `json:"attributes"`
 }
 
@@ -32,33 +36,28 @@ func (a *Relationship) UnmarshalJSON(data []byte) error {
 	typ := struct {
 		ID         string          `json:"id"`
 		Type       string          `json:"type"`
+		Related    string          `json:"related"`
 		Attributes json.RawMessage `json:"attributes"`
 	}{}
 	if err := json.Unmarshal(data, &typ); err != nil {
 		return err
 	}
 
-	var err error
-	switch typ.Type {
-	case MangaRel:
-		a.Attributes = &MangaAttributes{}
-	case AuthorRel:
-		a.Attributes = &AuthorAttributes{}
-	case ScanlationGroupRel:
-		a.Attributes = &ScanlationGroupAttributes{}
-	default:
-		a.Attributes = &json.RawMessage{}
-	}
-
 	a.ID = typ.ID
 	a.Type = typ.Type
-	if typ.Attributes != nil {
-		if err = json.Unmarshal(typ.Attributes, a.Attributes); err != nil {
-			return fmt.Errorf("error unmarshalling relationship of type %s: %s, %s", 
-				typ.Type, err.Error(), string(data))
-		}
+	a.Related = typ.Related
+	a.Attributes = nil
+
+	// Attributes are only present if the relationship was expanded with includes[].
+	if len(typ.Attributes) == 0 || string(typ.Attributes) == "null" {
+		return nil
+	}
+	a.Attributes = newRelationshipAttributes(typ.Type)
+	if err := json.Unmarshal(typ.Attributes, a.Attributes); err != nil {
+		return fmt.Errorf("error unmarshalling relationship of type %s: %s, %s",
+			typ.Type, err.Error(), string(data))
 	}
-	return err
+	return nil
 }
 
 // LocalisedStrings : A struct wrapping around a map containing each localised string.
@@ -152,3 +151,21 @@ type Error struct {
 	Title  string `json:"title"`
 	Detail string `json:"detail"`
 }
+
+// unmarshalMap : Unmarshal a JSON object into the map pointed to by v.
+// The API sends an empty map as an empty array, which is decoded as an empty map.
+func unmarshalMap(data json.RawMessage, v interface{}) error {
+	if isJSONArray(data) {
+		var list []json.RawMessage
+		if err := json.Unmarshal(data, &list); err != nil {
+			return err
+		}
+		if len(list) == 0 {
+			return nil
+		}
+	}
+	if len(data) == 0 || string(data) == "null" {
+		return nil
+	}
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,355 @@
+package mangodex
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strings"
+)
+
+const (
+	UploadsURL = "https://uploads.mangadex.org"
+
+	CoverListPath = "cover"
+	CoverPath     = "cover/%s"
+	CoverURLPath  = "covers/%s/%s"
+)
+
+// CoverSize : Size variant of a cover image.
+type CoverSize int
+
+// Cover sizes. Thumbnails are JPEG images with the given width.
+const (
+	CoverOriginal CoverSize = 0
+	Cover512      CoverSize = 512
+	Cover256      CoverSize = 256
+)
+
+// CoverService : Provides Cover services provided by the API.
+type CoverService service
+
+// CoverList : A response for getting a list of covers.
+type CoverList struct {
+	Result   string  `json:"result"`
+	Response string  `json:"response"`
+	Data     []Cover `json:"data"`
+	Limit    int     `json:"limit"`
+	Offset   int     `json:"offset"`
+	Total    int     `json:"total"`
+}
+
+func (cl *CoverList) GetResult() string {
+	return cl.Result
+}
+
+// CoverResponse : A response for getting a single Cover.
+type CoverResponse struct {
+	Result   string `json:"result"`
+	Response string `json:"response"`
+	Data     Cover  `json:"data"`
+}
+
+func (cr *CoverResponse) GetResult() string {
+	return cr.Result
+}
+
+// Cover : Struct containing information on a Cover.
+type Cover struct {
+	ID            string          `json:"id"`
+	Type          string          `json:"type"`
+	Attributes    CoverAttributes `json:"attributes"`
+	Relationships []Relationship  `json:"relationships"`
+}
+
+// GetMangaID : Get the ID of the Manga this cover belongs to.
+func (c *Cover) GetMangaID() string {
+	for _, rel := range c.Relationships {
+		if rel.Type == MangaRel {
+			return rel.ID
+		}
+	}
+	return ""
+}
+
+// CoverAttributes : Attributes for a Cover.
+type CoverAttributes struct {
+	Volume      *string `json:"volume"`
+	FileName    string  `json:"fileName"`
+	Description string  `json:"description"`
+	Locale      string  `json:"locale"`
+	Version     int     `json:"version"`
+	CreatedAt   string  `json:"createdAt"`
+	UpdatedAt   string  `json:"updatedAt"`
+}
+
+// BuildCoverURL : Get the URL of a cover image on the default uploads server.
+func BuildCoverURL(mangaID, fileName string, size CoverSize) string {
+	return buildCoverURL(UploadsURL, mangaID, fileName, size)
+}
+
+func buildCoverURL(uploadsURL, mangaID, fileName string, size CoverSize) string {
+	u := strings.TrimSuffix(uploadsURL, "/") + "/" + fmt.Sprintf(CoverURLPath, mangaID, fileName)
+	if size != CoverOriginal {
+		u += fmt.Sprintf(".%d.jpg", size)
+	}
+	return u
+}
+
+// SearchCovers : Get a list of Covers.
+// https://api.mangadex.org/docs.html#operation/get-cover
+func (s *CoverService) SearchCovers(q *CoverSearchQuery) (*CoverList, error) {
+	return s.SearchCoversContext(context.Background(), q)
+}
+
+// SearchCoversContext : SearchCovers with custom context.
+func (s *CoverService) SearchCoversContext(ctx context.Context, q *CoverSearchQuery) (*CoverList, error) {
+	params, err := q.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	u := s.client.buildURL(CoverListPath)
+	u.RawQuery = params.Encode()
+
+	var l CoverList
+	err = s.client.RequestAndDecode(ctx, http.MethodGet, u.String(), nil, &l)
+	return &l, err
+}
+
+// GetMangaVolumeCovers : Get the Covers of a Manga for the given volumes, or all its Covers if no volumes are given.
+// The API cannot search by volume, so all Covers of the Manga are fetched and then filtered.
+func (s *CoverService) GetMangaVolumeCovers(mangaID string, volumes []string) ([]Cover, error) {
+	return s.GetMangaVolumeCoversContext(context.Background(), mangaID, volumes)
+}
+
+// GetMangaVolumeCoversContext : GetMangaVolumeCovers with custom context.
+func (s *CoverService) GetMangaVolumeCoversContext(ctx context.Context, mangaID string, volumes []string) ([]Cover, error) {
+	f := &CoverFilter{Volumes: volumes}
+	q := &CoverSearchQuery{Limit: MaxPageSize, Manga: []string{mangaID}}
+
+	var covers []Cover
+	for {
+		l, err := s.SearchCoversContext(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		covers = append(covers, f.Apply(l.Data)...)
+
+		q.Offset += len(l.Data)
+		if len(l.Data) == 0 || q.Offset >= l.Total || q.Offset+q.Limit > MaxOffset {
+			return covers, nil
+		}
+	}
+}
+
+// CoverFilter : Filters on Cover attributes, which the API cannot search by.
+// The filter is applied to Covers that were already fetched, so it does not change the Total
+// of the CoverList they came from.
+type CoverFilter struct {
+	// Volumes : Volumes to match. An empty filter matches all Covers.
+	Volumes []string
+}
+
+// Match : Check if a Cover matches the filter.
+func (f *CoverFilter) Match(c *Cover) bool {
+	if len(f.Volumes) == 0 {
+		return true
+	}
+	for _, v := range f.Volumes {
+		if c.Attributes.Volume != nil && *c.Attributes.Volume == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply : Get the Covers matching the filter.
+func (f *CoverFilter) Apply(covers []Cover) []Cover {
+	var matched []Cover
+	for i := range covers {
+		if f.Match(&covers[i]) {
+			matched = append(matched, covers[i])
+		}
+	}
+	return matched
+}
+
+// GetCover : Get a Cover by ID.
+// https://api.mangadex.org/docs.html#operation/get-cover-id
+func (s *CoverService) GetCover(id string, includes []string) (*CoverResponse, error) {
+	return s.GetCoverContext(context.Background(), id, includes)
+}
+
+// GetCoverContext : GetCover with custom context.
+func (s *CoverService) GetCoverContext(ctx context.Context, id string, includes []string) (*CoverResponse, error) {
+	u := s.client.buildURL(fmt.Sprintf(CoverPath, id))
+
+	// Set query parameters
+	q := u.Query()
+	for _, i := range includes {
+		q.Add("includes[]", i)
+	}
+	u.RawQuery = q.Encode()
+
+	var r CoverResponse
+	err := s.client.RequestAndDecode(ctx, http.MethodGet, u.String(), nil, &r)
+	return &r, err
+}
+
+// UploadCover : Upload a cover image for a Manga. The volume and description may be empty.
+// https://api.mangadex.org/docs.html#operation/upload-cover
+func (s *CoverService) UploadCover(mangaID, volume, description string, image io.Reader, filename string) (*CoverResponse, error) {
+	return s.UploadCoverContext(context.Background(), mangaID, volume, description, image, filename)
+}
+
+// UploadCoverContext : UploadCover with custom context.
+func (s *CoverService) UploadCoverContext(ctx context.Context, mangaID, volume, description string, image io.Reader, filename string) (*CoverResponse, error) {
+	u := s.client.buildURL(fmt.Sprintf(CoverPath, mangaID))
+
+	data, err := ioutil.ReadAll(image)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the multipart body in a buffer, so that it can be sent again on retries.
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	if volume != "" {
+		if err = w.WriteField("volume", volume); err != nil {
+			return nil, err
+		}
+	}
+	if description != "" {
+		if err = w.WriteField("description", description); err != nil {
+			return nil, err
+		}
+	}
+
+	h := textproto.MIMEHeader{}
+	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename=%q`, filename))
+	h.Set("Content-Type", http.DetectContentType(data))
+	part, err := w.CreatePart(h)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = part.Write(data); err != nil {
+		return nil, err
+	}
+	if err = w.Close(); err != nil {
+		return nil, err
+	}
+
+	var r CoverResponse
+	err = s.client.RequestAndDecodeWithContentType(ctx, http.MethodPost, u.String(), w.FormDataContentType(), &body, &r)
+	return &r, err
+}
+
+// CoverEdit : Fields for updating a Cover.
+type CoverEdit struct {
+	// Volume : Volume of the cover. Nil removes the volume.
+	Volume      *string `json:"volume"`
+	Description *string `json:"description,omitempty"`
+	Locale      *string `json:"locale,omitempty"`
+	// Version : Current version of the Cover. The update fails if the Cover was modified since this version.
+	Version int `json:"version"`
+}
+
+// UpdateCover : Update a Cover. The edit must contain the current version of the Cover.
+// https://api.mangadex.org/docs.html#operation/edit-cover
+func (s *CoverService) UpdateCover(id string, edit CoverEdit) (*CoverResponse, error) {
+	return s.UpdateCoverContext(context.Background(), id, edit)
+}
+
+// UpdateCoverContext : UpdateCover with custom context.
+func (s *CoverService) UpdateCoverContext(ctx context.Context, id string, edit CoverEdit) (*CoverResponse, error) {
+	if edit.Version < 1 {
+		return nil, fmt.Errorf("updating cover %s requires its current version", id)
+	}
+	u := s.client.buildURL(fmt.Sprintf(CoverPath, id))
+
+	rBytes, err := json.Marshal(&edit)
+	if err != nil {
+		return nil, err
+	}
+
+	var r CoverResponse
+	err = s.client.RequestAndDecode(ctx, http.MethodPut, u.String(), bytes.NewBuffer(rBytes), &r)
+	return &r, err
+}
+
+// DeleteCover : Delete a Cover.
+// https://api.mangadex.org/docs.html#operation/delete-cover
+func (s *CoverService) DeleteCover(id string) (*Response, error) {
+	return s.DeleteCoverContext(context.Background(), id)
+}
+
+// DeleteCoverContext : DeleteCover with custom context.
+func (s *CoverService) DeleteCoverContext(ctx context.Context, id string) (*Response, error) {
+	u := s.client.buildURL(fmt.Sprintf(CoverPath, id))
+
+	var r Response
+	err := s.client.RequestAndDecode(ctx, http.MethodDelete, u.String(), nil, &r)
+	return &r, err
+}
+
+// GetCoverURL : Get the URL of a cover image on the configured uploads server.
+func (s *CoverService) GetCoverURL(mangaID, fileName string, size CoverSize) string {
+	return buildCoverURL(s.client.uploadsURL, mangaID, fileName, size)
+}
+
+// DownloadCover : Download a cover image.
+func (s *CoverService) DownloadCover(mangaID, fileName string, size CoverSize) ([]byte, error) {
+	return s.DownloadCoverContext(context.Background(), mangaID, fileName, size)
+}
+
+// DownloadCoverContext : DownloadCover with custom context.
+// Transient failures are retried according to the client's RetryPolicy.
+func (s *CoverService) DownloadCoverContext(ctx context.Context, mangaID, fileName string, size CoverSize) ([]byte, error) {
+	u := s.GetCoverURL(mangaID, fileName, size)
+
+	for attempt := 1; ; attempt++ {
+		data, err := s.download(ctx, u)
+		if err == nil {
+			return data, nil
+		}
+
+		wait, ok := s.client.retryPolicy().next(http.MethodGet, attempt, err)
+		if !ok {
+			return nil, withAttempts(err, attempt)
+		}
+		if serr := sleepContext(ctx, wait); serr != nil {
+			return nil, withAttempts(serr, attempt)
+		}
+	}
+}
+
+// download : Download a file from the uploads server once.
+func (s *CoverService) download(ctx context.Context, u string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, newAPIError(resp, data)
+	}
+	return data, nil
+}
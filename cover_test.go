@@ -0,0 +1,121 @@
+package mangodex
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCoverURL(t *testing.T) {
+	var m Manga
+	err := json.Unmarshal([]byte(`{"id":"m1","type":"manga","relationships":[
+		{"id":"a1","type":"author"},
+		{"id":"c1","type":"cover_art","attributes":{"fileName":"cover.jpg","volume":"1"}}
+	]}`), &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if u := m.GetCoverURL(Cover256); u != UploadsURL+"/covers/m1/cover.jpg.256.jpg" {
+		t.Errorf("unexpected thumbnail URL %s", u)
+	}
+	if u := m.GetCoverURL(CoverOriginal); u != UploadsURL+"/covers/m1/cover.jpg" {
+		t.Errorf("unexpected original URL %s", u)
+	}
+	if u := (&Manga{ID: "m2"}).GetCoverURL(Cover512); u != "" {
+		t.Errorf("expected no URL without cover_art relationship, got %s", u)
+	}
+}
+
+func TestDownloadCover(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/covers/m1/cover.jpg.512.jpg" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("image"))
+	}))
+	defer srv.Close()
+
+	dex := NewDexClient(WithUploadsURL(srv.URL))
+	data, err := dex.Cover.DownloadCover("m1", "cover.jpg", Cover512)
+	if err != nil || string(data) != "image" {
+		t.Errorf("unexpected download result %q: %v", data, err)
+	}
+	if _, err = dex.Cover.DownloadCover("m1", "missing.jpg", Cover512); !IsNotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestUploadCover(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n0000")
+
+	// Record the upload in the handler, and check it in the test goroutine.
+	var (
+		volume, filename, fileType, editType string
+		data                                 []byte
+	)
+	dex := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			f, fh, err := r.FormFile("file")
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			data, _ = ioutil.ReadAll(f)
+			volume, filename, fileType = r.FormValue("volume"), fh.Filename, fh.Header.Get("Content-Type")
+		case http.MethodPut:
+			editType = r.Header.Get("Content-Type")
+		}
+		_, _ = w.Write([]byte(`{"result":"ok","data":{"id":"c1","type":"cover_art"}}`))
+	}))
+
+	if _, err := dex.Cover.UploadCover(testUUID, "2", "", bytes.NewReader(png), "v2.png"); err != nil {
+		t.Fatalf("expected multipart upload to succeed: %v", err)
+	}
+	if volume != "2" || filename != "v2.png" || !bytes.Equal(data, png) || fileType != "image/png" {
+		t.Errorf("unexpected upload: volume %q, file %q (%s)", volume, filename, fileType)
+	}
+
+	v := "3"
+	if _, err := dex.Cover.UpdateCover("c1", CoverEdit{Volume: &v, Version: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if editType != JSONContentType {
+		t.Errorf("expected JSON content type for edits, got %s", editType)
+	}
+}
+
+func TestGetMangaVolumeCovers(t *testing.T) {
+	var requests int
+	dex := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Query().Get("manga[]") != testUUID || r.URL.Query().Get("limit") != "100" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		_, _ = w.Write([]byte(`{"result":"ok","data":[
+			{"id":"c1","type":"cover_art","attributes":{"volume":"1"}},
+			{"id":"c2","type":"cover_art","attributes":{"volume":"2"}},
+			{"id":"c3","type":"cover_art","attributes":{"volume":null}}
+		],"total":3}`))
+	}))
+
+	covers, err := dex.Cover.GetMangaVolumeCovers(testUUID, []string{"2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(covers) != 1 || covers[0].ID != "c2" || requests != 1 {
+		t.Errorf("unexpected covers %+v after %d requests", covers, requests)
+	}
+	if covers, _ = dex.Cover.GetMangaVolumeCovers(testUUID, nil); len(covers) != 3 {
+		t.Errorf("expected all covers, got %+v", covers)
+	}
+}
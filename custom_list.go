@@ -0,0 +1,268 @@
+package mangodex
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+const (
+	CustomListCreatePath         = "list"
+	CustomListPath               = "list/%s"
+	CustomListFeedPath           = "list/%s/feed"
+	ToggleCustomListFollowPath   = "list/%s/follow"
+	CustomListMangaPath          = "manga/%s/list/%s"
+	GetLoggedUserCustomListsPath = "user/list"
+	GetUserCustomListsPath       = "user/%s/list"
+)
+
+// CustomListService : Provides Custom List (MDList) services provided by the API.
+type CustomListService service
+
+// CustomListList : A response for getting a list of custom lists.
+type CustomListList struct {
+	Result   string       `json:"result"`
+	Response string       `json:"response"`
+	Data     []CustomList `json:"data"`
+	Limit    int          `json:"limit"`
+	Offset   int          `json:"offset"`
+	Total    int          `json:"total"`
+}
+
+func (cl *CustomListList) GetResult() string {
+	return cl.Result
+}
+
+// CustomListResponse : A response for getting a single Custom List.
+type CustomListResponse struct {
+	Result   string     `json:"result"`
+	Response string     `json:"response"`
+	Data     CustomList `json:"data"`
+}
+
+func (cr *CustomListResponse) GetResult() string {
+	return cr.Result
+}
+
+// CustomList : Struct containing information on a Custom List.
+type CustomList struct {
+	ID            string               `json:"id"`
+	Type          string               `json:"type"`
+	Attributes    CustomListAttributes `json:"attributes"`
+	Relationships []Relationship       `json:"relationships"`
+}
+
+// GetMangaIDs : Get the IDs of the Manga in the list.
+func (l *CustomList) GetMangaIDs() []string {
+	var ids []string
+	for _, rel := range l.Relationships {
+		if rel.Type == MangaRel {
+			ids = append(ids, rel.ID)
+		}
+	}
+	return ids
+}
+
+// CustomListAttributes : Attributes for a Custom List.
+type CustomListAttributes struct {
+	Name       string `json:"name"`
+	Visibility string `json:"visibility"`
+	Version    int    `json:"version"`
+}
+
+// CustomListEdit : Fields for creating or updating a Custom List.
+type CustomListEdit struct {
+	Name string `json:"name"`
+	// Visibility : PublicVisibility or PrivateVisibility. Empty uses the API default.
+	Visibility string   `json:"visibility,omitempty"`
+	Manga      []string `json:"manga,omitempty"`
+	// Version : Current version of the list, required for updates.
+	// The update fails if the list was modified since this version.
+	Version int `json:"version,omitempty"`
+}
+
+// CreateCustomList : Create a Custom List.
+// https://api.mangadex.org/docs.html#operation/post-list
+func (s *CustomListService) CreateCustomList(edit CustomListEdit) (*CustomListResponse, error) {
+	return s.CreateCustomListContext(context.Background(), edit)
+}
+
+// CreateCustomListContext : CreateCustomList with custom context.
+func (s *CustomListService) CreateCustomListContext(ctx context.Context, edit CustomListEdit) (*CustomListResponse, error) {
+	u := s.client.buildURL(CustomListCreatePath)
+
+	// A new list has no version.
+	edit.Version = 0
+	rBytes, err := json.Marshal(&edit)
+	if err != nil {
+		return nil, err
+	}
+
+	var r CustomListResponse
+	err = s.client.RequestAndDecode(ctx, http.MethodPost, u.String(), bytes.NewBuffer(rBytes), &r)
+	return &r, err
+}
+
+// GetCustomList : Get a Custom List by ID.
+// https://api.mangadex.org/docs.html#operation/get-list-id
+func (s *CustomListService) GetCustomList(id string) (*CustomListResponse, error) {
+	return s.GetCustomListContext(context.Background(), id)
+}
+
+// GetCustomListContext : GetCustomList with custom context.
+func (s *CustomListService) GetCustomListContext(ctx context.Context, id string) (*CustomListResponse, error) {
+	u := s.client.buildURL(fmt.Sprintf(CustomListPath, id))
+
+	var r CustomListResponse
+	err := s.client.RequestAndDecode(ctx, http.MethodGet, u.String(), nil, &r)
+	return &r, err
+}
+
+// UpdateCustomList : Update a Custom List. The edit must contain the current version of the list.
+// https://api.mangadex.org/docs.html#operation/put-list-id
+func (s *CustomListService) UpdateCustomList(id string, edit CustomListEdit) (*CustomListResponse, error) {
+	return s.UpdateCustomListContext(context.Background(), id, edit)
+}
+
+// UpdateCustomListContext : UpdateCustomList with custom context.
+func (s *CustomListService) UpdateCustomListContext(ctx context.Context, id string, edit CustomListEdit) (*CustomListResponse, error) {
+	if edit.Version < 1 {
+		return nil, fmt.Errorf("updating custom list %s requires its current version", id)
+	}
+	u := s.client.buildURL(fmt.Sprintf(CustomListPath, id))
+
+	rBytes, err := json.Marshal(&edit)
+	if err != nil {
+		return nil, err
+	}
+
+	var r CustomListResponse
+	err = s.client.RequestAndDecode(ctx, http.MethodPut, u.String(), bytes.NewBuffer(rBytes), &r)
+	return &r, err
+}
+
+// DeleteCustomList : Delete a Custom List.
+// https://api.mangadex.org/docs.html#operation/delete-list-id
+func (s *CustomListService) DeleteCustomList(id string) (*Response, error) {
+	return s.DeleteCustomListContext(context.Background(), id)
+}
+
+// DeleteCustomListContext : DeleteCustomList with custom context.
+func (s *CustomListService) DeleteCustomListContext(ctx context.Context, id string) (*Response, error) {
+	u := s.client.buildURL(fmt.Sprintf(CustomListPath, id))
+
+	var r Response
+	err := s.client.RequestAndDecode(ctx, http.MethodDelete, u.String(), nil, &r)
+	return &r, err
+}
+
+// AddMangaToCustomList : Add a Manga to a Custom List.
+// https://api.mangadex.org/docs.html#operation/post-manga-id-list-listId
+func (s *CustomListService) AddMangaToCustomList(mangaID, listID string) (*Response, error) {
+	return s.AddMangaToCustomListContext(context.Background(), mangaID, listID)
+}
+
+// AddMangaToCustomListContext : AddMangaToCustomList with custom context.
+func (s *CustomListService) AddMangaToCustomListContext(ctx context.Context, mangaID, listID string) (*Response, error) {
+	u := s.client.buildURL(fmt.Sprintf(CustomListMangaPath, mangaID, listID))
+
+	var r Response
+	err := s.client.RequestAndDecode(ctx, http.MethodPost, u.String(), nil, &r)
+	return &r, err
+}
+
+// RemoveMangaFromCustomList : Remove a Manga from a Custom List.
+// https://api.mangadex.org/docs.html#operation/delete-manga-id-list-listId
+func (s *CustomListService) RemoveMangaFromCustomList(mangaID, listID string) (*Response, error) {
+	return s.RemoveMangaFromCustomListContext(context.Background(), mangaID, listID)
+}
+
+// RemoveMangaFromCustomListContext : RemoveMangaFromCustomList with custom context.
+func (s *CustomListService) RemoveMangaFromCustomListContext(ctx context.Context, mangaID, listID string) (*Response, error) {
+	u := s.client.buildURL(fmt.Sprintf(CustomListMangaPath, mangaID, listID))
+
+	var r Response
+	err := s.client.RequestAndDecode(ctx, http.MethodDelete, u.String(), nil, &r)
+	return &r, err
+}
+
+// GetLoggedUserCustomLists : Get the Custom Lists of the logged in user.
+// https://api.mangadex.org/docs.html#operation/get-user-list
+func (s *CustomListService) GetLoggedUserCustomLists(limit, offset int) (*CustomListList, error) {
+	return s.GetLoggedUserCustomListsContext(context.Background(), limit, offset)
+}
+
+// GetLoggedUserCustomListsContext : GetLoggedUserCustomLists with custom context.
+func (s *CustomListService) GetLoggedUserCustomListsContext(ctx context.Context, limit, offset int) (*CustomListList, error) {
+	return s.getCustomLists(ctx, GetLoggedUserCustomListsPath, limit, offset)
+}
+
+// GetUserCustomLists : Get the public Custom Lists of a user.
+// https://api.mangadex.org/docs.html#operation/get-user-id-list
+func (s *CustomListService) GetUserCustomLists(userID string, limit, offset int) (*CustomListList, error) {
+	return s.GetUserCustomListsContext(context.Background(), userID, limit, offset)
+}
+
+// GetUserCustomListsContext : GetUserCustomLists with custom context.
+func (s *CustomListService) GetUserCustomListsContext(ctx context.Context, userID string, limit, offset int) (*CustomListList, error) {
+	return s.getCustomLists(ctx, fmt.Sprintf(GetUserCustomListsPath, userID), limit, offset)
+}
+
+// getCustomLists : Get a page of Custom Lists from a path.
+func (s *CustomListService) getCustomLists(ctx context.Context, path string, limit, offset int) (*CustomListList, error) {
+	u := s.client.buildURL(path)
+
+	// Set required query parameters
+	q := u.Query()
+	q.Add("limit", strconv.Itoa(limit))
+	q.Add("offset", strconv.Itoa(offset))
+	u.RawQuery = q.Encode()
+
+	var l CustomListList
+	err := s.client.RequestAndDecode(ctx, http.MethodGet, u.String(), nil, &l)
+	return &l, err
+}
+
+// ToggleCustomListFollowStatus : Toggle follow status for a Custom List.
+func (s *CustomListService) ToggleCustomListFollowStatus(id string, toFollow bool) (*Response, error) {
+	return s.ToggleCustomListFollowStatusContext(context.Background(), id, toFollow)
+}
+
+// ToggleCustomListFollowStatusContext : ToggleCustomListFollowStatus with custom context.
+func (s *CustomListService) ToggleCustomListFollowStatusContext(ctx context.Context, id string, toFollow bool) (*Response, error) {
+	u := s.client.buildURL(fmt.Sprintf(ToggleCustomListFollowPath, id))
+
+	method := http.MethodPost // To follow
+	if !toFollow {
+		method = http.MethodDelete // To unfollow
+	}
+
+	var r Response
+	err := s.client.RequestAndDecode(ctx, method, u.String(), nil, &r)
+	return &r, err
+}
+
+// GetCustomListFeed : Get the chapter feed of a Custom List, filtered by a feed query.
+// A nil query returns the unfiltered feed.
+// https://api.mangadex.org/docs.html#operation/get-list-id-feed
+func (s *CustomListService) GetCustomListFeed(id string, q *ChapterFeedQuery) (*ChapterList, error) {
+	return s.GetCustomListFeedContext(context.Background(), id, q)
+}
+
+// GetCustomListFeedContext : GetCustomListFeed with custom context.
+func (s *CustomListService) GetCustomListFeedContext(ctx context.Context, id string, q *ChapterFeedQuery) (*ChapterList, error) {
+	params, err := q.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	u := s.client.buildURL(fmt.Sprintf(CustomListFeedPath, id))
+	u.RawQuery = params.Encode()
+
+	var l ChapterList
+	err = s.client.RequestAndDecode(ctx, http.MethodGet, u.String(), nil, &l)
+	return &l, err
+}
@@ -0,0 +1,113 @@
+package mangodex
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// APIError : Error returned when the MangaDex API responds with a non-200 status code.
+type APIError struct {
+	StatusCode int
+	RequestID  string
+	Errors     []Error
+
+	// Body : Raw response body, kept when it could not be decoded into an ErrorResponse.
+	Body string
+
+	// RetryAfter : How long the API asks to wait before retrying, for rate limited or unavailable responses.
+	RetryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("non-200 status code -> (%d)", e.StatusCode))
+	if e.RequestID != "" {
+		b.WriteString(fmt.Sprintf(" [request %s]", e.RequestID))
+	}
+	for _, err := range e.Errors {
+		b.WriteString(fmt.Sprintf(" %s: %s;", err.Title, err.Detail))
+	}
+	if len(e.Errors) == 0 && e.Body != "" {
+		b.WriteString(" " + e.Body)
+	}
+	return strings.TrimSuffix(b.String(), ";")
+}
+
+// newAPIError : Build an APIError from a response and its body.
+// Bodies that are not a valid ErrorResponse (HTML from a proxy, empty bodies) are kept as-is.
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	e := &APIError{
+		StatusCode: resp.StatusCode,
+		RequestID:  resp.Header.Get("X-Request-ID"),
+	}
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+		e.RetryAfter = parseRetryAfter(resp.Header)
+	}
+
+	var er ErrorResponse
+	if err := json.Unmarshal(body, &er); err == nil && len(er.Errors) > 0 {
+		e.Errors = er.Errors
+	} else {
+		e.Body = strings.TrimSpace(string(body))
+	}
+	return e
+}
+
+// hasErrorTitle : Check if any of the API errors has a title containing s (case-insensitive).
+func (e *APIError) hasErrorTitle(s string) bool {
+	for _, err := range e.Errors {
+		if strings.Contains(strings.ToLower(err.Title), s) {
+			return true
+		}
+	}
+	return false
+}
+
+// asAPIError : Get the APIError from an error chain, if any.
+func asAPIError(err error) (*APIError, bool) {
+	var e *APIError
+	ok := errors.As(err, &e)
+	return e, ok
+}
+
+// hasStatus : Check if err is an APIError with the given status code.
+func hasStatus(err error, code int) bool {
+	e, ok := asAPIError(err)
+	return ok && e.StatusCode == code
+}
+
+// IsNotFound : Check if the error is due to the requested resource not existing.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsUnauthorized : Check if the error is due to missing or expired authentication.
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// IsForbidden : Check if the error is due to the user not having permission for the action.
+func IsForbidden(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
+
+// IsRateLimited : Check if the error is due to the client being rate limited.
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
+
+// IsCaptchaRequired : Check if the API requires a captcha to be solved before the request can succeed.
+func IsCaptchaRequired(err error) bool {
+	e, ok := asAPIError(err)
+	return ok && (e.StatusCode == http.StatusPreconditionFailed || e.hasErrorTitle("captcha"))
+}
+
+// IsValidation : Check if the error is due to invalid request parameters or body.
+func IsValidation(err error) bool {
+	e, ok := asAPIError(err)
+	return ok && (e.StatusCode == http.StatusBadRequest || e.hasErrorTitle("validation"))
+}
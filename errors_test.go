@@ -0,0 +1,48 @@
+package mangodex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAPIError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-ID", "req-1")
+		switch r.URL.Path {
+		case "/json":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"result":"error","errors":[{"id":"1","status":404,"title":"not_found","detail":"Manga not found"}]}`))
+		case "/html":
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = w.Write([]byte(`<html>Bad Gateway</html>`))
+		case "/empty":
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer srv.Close()
+
+	dex := NewDexClient()
+	dex.SetRetryPolicy(nil)
+
+	_, err := dex.Request(context.Background(), http.MethodGet, srv.URL+"/json", nil)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %v", err)
+	}
+	if !IsNotFound(err) || apiErr.RequestID != "req-1" || len(apiErr.Errors) != 1 {
+		t.Errorf("unexpected error: %+v", apiErr)
+	}
+
+	_, err = dex.Request(context.Background(), http.MethodGet, srv.URL+"/html", nil)
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadGateway || apiErr.Body != "<html>Bad Gateway</html>" {
+		t.Errorf("unexpected error for HTML body: %v", err)
+	}
+
+	_, err = dex.Request(context.Background(), http.MethodGet, srv.URL+"/empty", nil)
+	if !IsRateLimited(err) || IsNotFound(err) {
+		t.Errorf("unexpected error for empty body: %v", err)
+	}
+}
@@ -0,0 +1,26 @@
+package mangodex
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newTestClient : Create a DexClient talking to a test server with the given handler.
+func newTestClient(t *testing.T, h http.Handler) *DexClient {
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+
+	return NewDexClient(WithBaseURL(srv.URL), WithRateLimiter(nil))
+}
+
+// fakeJWT : Create an unsigned JWT with the given subject and expiry.
+func fakeJWT(sub string, exp time.Time) string {
+	enc := base64.RawURLEncoding
+	header := enc.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+	claims := enc.EncodeToString([]byte(fmt.Sprintf(`{"sub":%q,"exp":%d}`, sub, exp.Unix())))
+	return header + "." + claims + ".sig"
+}
@@ -0,0 +1,146 @@
+package mangodex
+
+import (
+	"context"
+)
+
+// attributesFetcher : Fetch the attributes of entities by ID, returning a constructor for a copy of each entity's attributes.
+type attributesFetcher func(ctx context.Context, c *DexClient, ids []string) (map[string]func() interface{}, error)
+
+// hydrateFetchers : Fetchers for each relationship type supported by HydrateRelationships.
+var hydrateFetchers = map[string]attributesFetcher{
+	MangaRel:           fetchMangaAttributes,
+	AuthorRel:          fetchAuthorAttributes,
+	ArtistRel:          fetchAuthorAttributes,
+	ScanlationGroupRel: fetchScanlationGroupAttributes,
+	CoverArtRel:        fetchCoverAttributes,
+}
+
+// HydrateManga : Fill in the attributes of the relationships of the Manga that were not expanded with includes[].
+// See HydrateRelationships.
+func (c *DexClient) HydrateManga(ctx context.Context, manga []Manga) error {
+	rels := make([][]Relationship, len(manga))
+	for i := range manga {
+		rels[i] = manga[i].Relationships
+	}
+	return c.HydrateRelationships(ctx, rels...)
+}
+
+// HydrateChapters : Fill in the attributes of the relationships of the Chapters that were not expanded with includes[].
+// See HydrateRelationships.
+func (c *DexClient) HydrateChapters(ctx context.Context, chapters []Chapter) error {
+	rels := make([][]Relationship, len(chapters))
+	for i := range chapters {
+		rels[i] = chapters[i].Relationships
+	}
+	return c.HydrateRelationships(ctx, rels...)
+}
+
+// HydrateRelationships : Fill in the attributes of relationships that were not expanded with includes[],
+// in place. Missing attributes are fetched in batches by ID, with one request per type for up to
+// maxIDsPerRequest IDs. Manga, author, artist, scanlation group and cover art relationships are supported;
+// other relationships are left unchanged.
+func (c *DexClient) HydrateRelationships(ctx context.Context, rels ...[]Relationship) error {
+	// Collect the missing IDs of each type, without duplicates.
+	missing := map[string][]string{}
+	seen := map[string]bool{}
+	for _, list := range rels {
+		for _, rel := range list {
+			if _, ok := hydrateFetchers[rel.Type]; !ok || rel.Attributes != nil {
+				continue
+			}
+			typ := rel.Type
+			if typ == ArtistRel {
+				// Artists are authors, so fetch them together.
+				typ = AuthorRel
+			}
+			if key := typ + "/" + rel.ID; !seen[key] {
+				seen[key] = true
+				missing[typ] = append(missing[typ], rel.ID)
+			}
+		}
+	}
+
+	found := map[string]func() interface{}{}
+	for typ, ids := range missing {
+		for _, chunk := range chunkIDs(ids, maxIDsPerRequest) {
+			attrs, err := hydrateFetchers[typ](ctx, c, chunk)
+			if err != nil {
+				return err
+			}
+			for id, newAttrs := range attrs {
+				found[typ+"/"+id] = newAttrs
+			}
+		}
+	}
+
+	for _, list := range rels {
+		for i := range list {
+			typ := list[i].Type
+			if typ == ArtistRel {
+				typ = AuthorRel
+			}
+			if newAttrs, ok := found[typ+"/"+list[i].ID]; ok && list[i].Attributes == nil {
+				list[i].Attributes = newAttrs()
+			}
+		}
+	}
+	return nil
+}
+
+func fetchMangaAttributes(ctx context.Context, c *DexClient, ids []string) (map[string]func() interface{}, error) {
+	// Request every content rating, as the API otherwise leaves out pornographic Manga.
+	l, err := c.Manga.SearchMangaContext(ctx, &MangaSearchQuery{
+		Limit:         len(ids),
+		IDs:           ids,
+		ContentRating: []string{Safe, Suggestive, Erotica, Porn},
+	})
+	if err != nil {
+		return nil, err
+	}
+	attrs := map[string]func() interface{}{}
+	for _, m := range l.Data {
+		a := m.Attributes
+		attrs[m.ID] = func() interface{} { cp := a; return &cp }
+	}
+	return attrs, nil
+}
+
+func fetchAuthorAttributes(ctx context.Context, c *DexClient, ids []string) (map[string]func() interface{}, error) {
+	l, err := c.Author.SearchAuthorsContext(ctx, &AuthorSearchQuery{Limit: len(ids), IDs: ids})
+	if err != nil {
+		return nil, err
+	}
+	attrs := map[string]func() interface{}{}
+	for _, au := range l.Data {
+		a := au.Attributes
+		attrs[au.ID] = func() interface{} { cp := a; return &cp }
+	}
+	return attrs, nil
+}
+
+func fetchScanlationGroupAttributes(ctx context.Context, c *DexClient, ids []string) (map[string]func() interface{}, error) {
+	l, err := c.ScanlationGroup.SearchScanlationGroupsContext(ctx, &ScanlationGroupSearchQuery{Limit: len(ids), IDs: ids})
+	if err != nil {
+		return nil, err
+	}
+	attrs := map[string]func() interface{}{}
+	for _, g := range l.Data {
+		a := g.Attributes
+		attrs[g.ID] = func() interface{} { cp := a; return &cp }
+	}
+	return attrs, nil
+}
+
+func fetchCoverAttributes(ctx context.Context, c *DexClient, ids []string) (map[string]func() interface{}, error) {
+	l, err := c.Cover.SearchCoversContext(ctx, &CoverSearchQuery{Limit: len(ids), IDs: ids})
+	if err != nil {
+		return nil, err
+	}
+	attrs := map[string]func() interface{}{}
+	for _, cv := range l.Data {
+		a := cv.Attributes
+		attrs[cv.ID] = func() interface{} { cp := a; return &cp }
+	}
+	return attrs, nil
+}
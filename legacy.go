@@ -0,0 +1,176 @@
+package mangodex
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+const (
+	LegacyMappingPath = "legacy/mapping"
+)
+
+// Legacy mapping types
+const (
+	LegacyMangaType   string = "manga"
+	LegacyChapterType string = "chapter"
+	LegacyGroupType   string = "group"
+	LegacyTagType     string = "tag"
+)
+
+// LegacyService : Provides services to map legacy numeric IDs to UUIDs.
+type LegacyService service
+
+// LegacyMappingList : A response for mapping legacy IDs.
+type LegacyMappingList struct {
+	Result   string          `json:"result"`
+	Response string          `json:"response"`
+	Data     []LegacyMapping `json:"data"`
+	Limit    int             `json:"limit"`
+	Offset   int             `json:"offset"`
+	Total    int             `json:"total"`
+}
+
+func (ll *LegacyMappingList) GetResult() string {
+	return ll.Result
+}
+
+// LegacyMapping : Mapping of a legacy ID to its UUID.
+type LegacyMapping struct {
+	ID         string                  `json:"id"`
+	Type       string                  `json:"type"`
+	Attributes LegacyMappingAttributes `json:"attributes"`
+}
+
+// LegacyMappingAttributes : Attributes for a LegacyMapping.
+type LegacyMappingAttributes struct {
+	Type     string `json:"type"`
+	LegacyID int    `json:"legacyId"`
+	NewID    string `json:"newId"`
+}
+
+// GetUUIDs : Map legacy numeric IDs of a type, such as LegacyMangaType, to UUIDs.
+// Returns a map of legacy ID to UUID. IDs without a mapping are omitted.
+// Large sets of IDs are split over several requests.
+// https://api.mangadex.org/docs.html#operation/post-legacy-mapping
+func (s *LegacyService) GetUUIDs(typ string, ids []int) (map[int]string, error) {
+	return s.GetUUIDsContext(context.Background(), typ, ids)
+}
+
+// GetUUIDsContext : GetUUIDs with custom context.
+func (s *LegacyService) GetUUIDsContext(ctx context.Context, typ string, ids []int) (map[int]string, error) {
+	if err := checkEnum("type", []string{typ}, LegacyMangaType, LegacyChapterType, LegacyGroupType, LegacyTagType); err != nil {
+		return nil, fmt.Errorf("invalid legacy mapping: %s", err.Error())
+	}
+
+	uuids := map[int]string{}
+	for len(ids) > 0 {
+		n := len(ids)
+		if n > maxIDsPerRequest {
+			n = maxIDsPerRequest
+		}
+		chunk := ids[:n]
+		ids = ids[n:]
+
+		l, err := s.getMapping(ctx, typ, chunk)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range l.Data {
+			uuids[m.Attributes.LegacyID] = m.Attributes.NewID
+		}
+	}
+	return uuids, nil
+}
+
+// getMapping : Map a single batch of legacy IDs.
+func (s *LegacyService) getMapping(ctx context.Context, typ string, ids []int) (*LegacyMappingList, error) {
+	u := s.client.buildURL(LegacyMappingPath)
+
+	rBytes, err := json.Marshal(&struct {
+		Type string `json:"type"`
+		IDs  []int  `json:"ids"`
+	}{typ, ids})
+	if err != nil {
+		return nil, err
+	}
+
+	var l LegacyMappingList
+	err = s.client.RequestAndDecode(ctx, http.MethodPost, u.String(), bytes.NewBuffer(rBytes), &l)
+	return &l, err
+}
+
+// LegacyCache : Caches mappings of legacy IDs to UUIDs in memory, so each legacy ID is only resolved once.
+// Legacy IDs without a mapping are cached too, and are not requested again until Clear is called.
+// A LegacyCache is safe for concurrent use.
+type LegacyCache struct {
+	service *LegacyService
+
+	mu sync.Mutex
+	// uuids : Map of type to legacy ID to UUID. IDs without a mapping have an empty UUID.
+	uuids map[string]map[int]string
+}
+
+// NewLegacyCache : Create a LegacyCache resolving IDs with the given client.
+func NewLegacyCache(c *DexClient) *LegacyCache {
+	return &LegacyCache{service: c.Legacy, uuids: map[string]map[int]string{}}
+}
+
+// Get : Get the cached UUID of a legacy ID. Returns false if the ID has not been resolved or has no mapping.
+func (c *LegacyCache) Get(typ string, id int) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	uuid := c.uuids[typ][id]
+	return uuid, uuid != ""
+}
+
+// Clear : Remove all cached mappings, including legacy IDs cached as having no mapping.
+func (c *LegacyCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.uuids = map[string]map[int]string{}
+}
+
+// Resolve : Map legacy IDs of a type to UUIDs, like LegacyService.GetUUIDs.
+// Only IDs not already in the cache are requested.
+func (c *LegacyCache) Resolve(ctx context.Context, typ string, ids []int) (map[int]string, error) {
+	uuids := map[int]string{}
+	var missing []int
+
+	c.mu.Lock()
+	for _, id := range ids {
+		uuid, ok := c.uuids[typ][id]
+		if !ok {
+			missing = append(missing, id)
+		} else if uuid != "" {
+			uuids[id] = uuid
+		}
+	}
+	c.mu.Unlock()
+
+	if len(missing) == 0 {
+		return uuids, nil
+	}
+	resolved, err := c.service.GetUUIDsContext(ctx, typ, missing)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.uuids[typ] == nil {
+		c.uuids[typ] = map[int]string{}
+	}
+	for _, id := range missing {
+		// IDs without a mapping are stored with an empty UUID.
+		uuid := resolved[id]
+		c.uuids[typ][id] = uuid
+		if uuid != "" {
+			uuids[id] = uuid
+		}
+	}
+	return uuids, nil
+}
@@ -1,15 +1,20 @@
 package mangodex
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
-	"strings"
 )
 
 const (
 	MangaListPath            = "manga"
+	MangaPath                = "manga/%s"
+	MangaTagPath             = "manga/tag"
+	MangaReadingStatusPath   = "manga/%s/status"
+	MangaReadingStatusesPath = "manga/status"
 	CheckIfMangaFollowedPath = "user/follows/manga/%s"
 	ToggleMangaFollowPath    = "manga/%s/follow"
 )
@@ -31,6 +36,17 @@ func (ml *MangaList) GetResult() string {
 	return ml.Result
 }
 
+// MangaResponse : A response for getting a single Manga.
+type MangaResponse struct {
+	Result   string `json:"result"`
+	Response string `json:"response"`
+	Data     Manga  `json:"data"`
+}
+
+func (mr *MangaResponse) GetResult() string {
+	return mr.Result
+}
+
 // Manga : Struct containing information on a Manga.
 type Manga struct {
 	ID            string          `json:"id"`
@@ -52,6 +68,72 @@ func (m *Manga) GetDescription(langCode string) string {
 	return m.Attributes.Description.GetLocalString(langCode)
 }
 
+// RelatedManga : A Manga related to another Manga.
+type RelatedManga struct {
+	// Related : How the Manga relates to the other Manga, such as "sequel" or "spin_off".
+	Related string
+	Manga   Manga
+}
+
+// Authors : Get the authors of the Manga.
+// Returns false if any author was not expanded with includes[]=author, in which case only its ID is set.
+func (m *Manga) Authors() ([]Author, bool) {
+	return relationshipAuthors(m.Relationships, AuthorRel)
+}
+
+// Artists : Get the artists of the Manga.
+// Returns false if any artist was not expanded with includes[]=artist, in which case only its ID is set.
+func (m *Manga) Artists() ([]Author, bool) {
+	return relationshipAuthors(m.Relationships, ArtistRel)
+}
+
+// CoverArt : Get the main cover of the Manga, or nil if it has none.
+// Returns false if the cover was not expanded with includes[]=cover_art, in which case only its ID is set.
+func (m *Manga) CoverArt() (*Cover, bool) {
+	for _, rel := range m.Relationships {
+		if rel.Type == CoverArtRel {
+			c := &Cover{ID: rel.ID, Type: CoverArtRel}
+			attrs, ok := rel.AsCoverArt()
+			if ok {
+				c.Attributes = *attrs
+			}
+			return c, ok
+		}
+	}
+	return nil, false
+}
+
+// RelatedManga : Get the Manga related to the Manga.
+// Returns false if any related Manga was not expanded with includes[]=manga, in which case only its ID is set.
+func (m *Manga) RelatedManga() ([]RelatedManga, bool) {
+	var related []RelatedManga
+	expanded := true
+	for _, rel := range m.Relationships {
+		if rel.Type != MangaRel {
+			continue
+		}
+		r := RelatedManga{Related: rel.Related, Manga: Manga{ID: rel.ID, Type: MangaRel}}
+		if attrs, ok := rel.AsManga(); ok {
+			r.Manga.Attributes = *attrs
+		} else {
+			expanded = false
+		}
+		related = append(related, r)
+	}
+	return related, expanded
+}
+
+// GetCoverURL : Get the URL of the Manga's cover in the given size.
+// Returns an empty string unless the Manga was fetched with includes[]=cover_art.
+func (m *Manga) GetCoverURL(size CoverSize) string {
+	for _, rel := range m.Relationships {
+		if attrs, ok := rel.AsCoverArt(); ok && attrs.FileName != "" {
+			return BuildCoverURL(m.ID, attrs.FileName, size)
+		}
+	}
+	return ""
+}
+
 // MangaAttributes : Attributes for a Manga.
 type MangaAttributes struct {
 	Title                  LocalisedStrings `json:"title"`
@@ -81,8 +163,7 @@ func (s *MangaService) GetMangaList(params url.Values) (*MangaList, error) {
 
 // GetMangaListContext : GetMangaList with custom context.
 func (s *MangaService) GetMangaListContext(ctx context.Context, params url.Values) (*MangaList, error) {
-	u, _ := url.Parse(BaseAPI)
-	u.Path = MangaListPath
+	u := s.client.buildURL(MangaListPath)
 
 	// Set query parameters
 	u.RawQuery = params.Encode()
@@ -92,6 +173,64 @@ func (s *MangaService) GetMangaListContext(ctx context.Context, params url.Value
 	return &l, err
 }
 
+// GetManga : Get a Manga by ID.
+// https://api.mangadex.org/docs.html#operation/get-manga-id
+func (s *MangaService) GetManga(id string, includes []string) (*MangaResponse, error) {
+	return s.GetMangaContext(context.Background(), id, includes)
+}
+
+// GetMangaContext : GetManga with custom context.
+func (s *MangaService) GetMangaContext(ctx context.Context, id string, includes []string) (*MangaResponse, error) {
+	u := s.client.buildURL(fmt.Sprintf(MangaPath, id))
+
+	// Set query parameters
+	q := u.Query()
+	for _, i := range includes {
+		q.Add("includes[]", i)
+	}
+	u.RawQuery = q.Encode()
+
+	var r MangaResponse
+	err := s.client.RequestAndDecode(ctx, http.MethodGet, u.String(), nil, &r)
+	return &r, err
+}
+
+// SearchManga : Search for Manga with a typed query.
+// https://api.mangadex.org/docs.html#operation/get-search-manga
+func (s *MangaService) SearchManga(q *MangaSearchQuery) (*MangaList, error) {
+	return s.SearchMangaContext(context.Background(), q)
+}
+
+// SearchMangaContext : SearchManga with custom context.
+func (s *MangaService) SearchMangaContext(ctx context.Context, q *MangaSearchQuery) (*MangaList, error) {
+	params, err := q.Values()
+	if err != nil {
+		return nil, err
+	}
+	return s.GetMangaListContext(ctx, params)
+}
+
+// IterateMangaList : Get a MangaIterator over all Manga matching the parameters.
+// Pages are fetched lazily using GetMangaListContext.
+func (s *MangaService) IterateMangaList(params url.Values) *MangaIterator {
+	return newMangaIterator(params, s.GetMangaListContext)
+}
+
+// GetTags : Get the full catalogue of Manga tags.
+// https://api.mangadex.org/docs.html#operation/get-manga-tag
+func (s *MangaService) GetTags() (*TagList, error) {
+	return s.GetTagsContext(context.Background())
+}
+
+// GetTagsContext : GetTags with custom context.
+func (s *MangaService) GetTagsContext(ctx context.Context) (*TagList, error) {
+	u := s.client.buildURL(MangaTagPath)
+
+	var l TagList
+	err := s.client.RequestAndDecode(ctx, http.MethodGet, u.String(), nil, &l)
+	return &l, err
+}
+
 // CheckIfMangaFollowed : Check if a user follows a manga.
 func (s *MangaService) CheckIfMangaFollowed(id string) (bool, error) {
 	return s.CheckIfMangaFollowedContext(context.Background(), id)
@@ -99,13 +238,12 @@ func (s *MangaService) CheckIfMangaFollowed(id string) (bool, error) {
 
 // CheckIfMangaFollowedContext : CheckIfMangaFollowed with custom context.
 func (s *MangaService) CheckIfMangaFollowedContext(ctx context.Context, id string) (bool, error) {
-	u, _ := url.Parse(BaseAPI)
-	u.Path = fmt.Sprintf(CheckIfMangaFollowedPath, id)
+	u := s.client.buildURL(fmt.Sprintf(CheckIfMangaFollowedPath, id))
 
 	var r Response
 	err := s.client.RequestAndDecode(ctx, http.MethodGet, u.String(), nil, &r)
 	if err != nil {
-		if strings.Contains(err.Error(), "404") {
+		if IsNotFound(err) {
 			return false, nil
 		}
 		return false, err
@@ -120,8 +258,7 @@ func (s *MangaService) ToggleMangaFollowStatus(id string, toFollow bool) (*Respo
 
 // ToggleMangaFollowStatusContext  ToggleMangaFollowStatus with custom context.
 func (s *MangaService) ToggleMangaFollowStatusContext(ctx context.Context, id string, toFollow bool) (*Response, error) {
-	u, _ := url.Parse(BaseAPI)
-	u.Path = fmt.Sprintf(ToggleMangaFollowPath, id)
+	u := s.client.buildURL(fmt.Sprintf(ToggleMangaFollowPath, id))
 
 	method := http.MethodPost // To follow
 	if !toFollow {
@@ -132,3 +269,111 @@ func (s *MangaService) ToggleMangaFollowStatusContext(ctx context.Context, id st
 	err := s.client.RequestAndDecode(ctx, method, u.String(), nil, &r)
 	return &r, err
 }
+
+// ReadingStatusResponse : A response for getting the reading status of a Manga.
+type ReadingStatusResponse struct {
+	Result string `json:"result"`
+	// Status : The reading status, or empty if the Manga has none.
+	Status ReadingStatus `json:"status"`
+}
+
+func (r *ReadingStatusResponse) GetResult() string {
+	return r.Result
+}
+
+// ReadingStatusesResponse : A response for getting the reading statuses of all Manga.
+type ReadingStatusesResponse struct {
+	Result string `json:"result"`
+	// Statuses : Map of Manga ID to reading status.
+	Statuses map[string]ReadingStatus `json:"statuses"`
+}
+
+func (r *ReadingStatusesResponse) GetResult() string {
+	return r.Result
+}
+
+func (r *ReadingStatusesResponse) UnmarshalJSON(data []byte) error {
+	raw := struct {
+		Result   string          `json:"result"`
+		Statuses json.RawMessage `json:"statuses"`
+	}{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	r.Result = raw.Result
+	r.Statuses = map[string]ReadingStatus{}
+	return unmarshalMap(raw.Statuses, &r.Statuses)
+}
+
+// GetReadingStatus : Get the logged in user's reading status for a Manga.
+// Returns an empty status if the Manga has none.
+// https://api.mangadex.org/docs.html#operation/get-manga-id-status
+func (s *MangaService) GetReadingStatus(id string) (ReadingStatus, error) {
+	return s.GetReadingStatusContext(context.Background(), id)
+}
+
+// GetReadingStatusContext : GetReadingStatus with custom context.
+func (s *MangaService) GetReadingStatusContext(ctx context.Context, id string) (ReadingStatus, error) {
+	u := s.client.buildURL(fmt.Sprintf(MangaReadingStatusPath, id))
+
+	var r ReadingStatusResponse
+	err := s.client.RequestAndDecode(ctx, http.MethodGet, u.String(), nil, &r)
+	return r.Status, err
+}
+
+// SetReadingStatus : Set the logged in user's reading status for a Manga.
+// An empty status clears the reading status.
+// https://api.mangadex.org/docs.html#operation/post-manga-id-status
+func (s *MangaService) SetReadingStatus(id string, status ReadingStatus) (*Response, error) {
+	return s.SetReadingStatusContext(context.Background(), id, status)
+}
+
+// SetReadingStatusContext : SetReadingStatus with custom context.
+func (s *MangaService) SetReadingStatusContext(ctx context.Context, id string, status ReadingStatus) (*Response, error) {
+	// A nil status is sent as null, which clears the reading status.
+	var body struct {
+		Status *ReadingStatus `json:"status"`
+	}
+	if status != "" {
+		if !status.Valid() {
+			return nil, fmt.Errorf("invalid reading status %q", status)
+		}
+		body.Status = &status
+	}
+	rBytes, err := json.Marshal(&body)
+	if err != nil {
+		return nil, err
+	}
+
+	u := s.client.buildURL(fmt.Sprintf(MangaReadingStatusPath, id))
+
+	var r Response
+	err = s.client.RequestAndDecode(ctx, http.MethodPost, u.String(), bytes.NewBuffer(rBytes), &r)
+	return &r, err
+}
+
+// GetAllReadingStatuses : Get the logged in user's reading statuses for all Manga, as a map of Manga ID to status.
+// A non-empty filter only returns Manga with that status.
+// https://api.mangadex.org/docs.html#operation/get-manga-status
+func (s *MangaService) GetAllReadingStatuses(filter ReadingStatus) (map[string]ReadingStatus, error) {
+	return s.GetAllReadingStatusesContext(context.Background(), filter)
+}
+
+// GetAllReadingStatusesContext : GetAllReadingStatuses with custom context.
+func (s *MangaService) GetAllReadingStatusesContext(ctx context.Context, filter ReadingStatus) (map[string]ReadingStatus, error) {
+	u := s.client.buildURL(MangaReadingStatusesPath)
+
+	if filter != "" {
+		if !filter.Valid() {
+			return nil, fmt.Errorf("invalid reading status %q", filter)
+		}
+		q := u.Query()
+		q.Set("status", string(filter))
+		u.RawQuery = q.Encode()
+	}
+
+	var r ReadingStatusesResponse
+	err := s.client.RequestAndDecode(ctx, http.MethodGet, u.String(), nil, &r)
+	return r.Statuses, err
+}
@@ -0,0 +1,97 @@
+package mangodex
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Option : Configures a DexClient. Pass options to NewDexClient.
+type Option func(*DexClient)
+
+// WithBaseURL : Use a different base URL for the API, such as the MangaDex sandbox or a local test server.
+// The URL must be absolute. If it is invalid, every request of the client fails with the parse error.
+func WithBaseURL(baseURL string) Option {
+	return func(c *DexClient) {
+		u, err := url.Parse(baseURL)
+		if err == nil && (u.Scheme == "" || u.Host == "") {
+			err = fmt.Errorf("base URL %q is not absolute", baseURL)
+		}
+		if err != nil {
+			c.configErr = fmt.Errorf("invalid base URL: %s", err.Error())
+			return
+		}
+		c.baseURL = u
+	}
+}
+
+// WithReportURL : Use a different URL for reporting MangaDex@Home page downloads.
+func WithReportURL(reportURL string) Option {
+	return func(c *DexClient) {
+		c.reportURL = reportURL
+	}
+}
+
+// WithUploadsURL : Use a different URL for downloading cover images.
+func WithUploadsURL(uploadsURL string) Option {
+	return func(c *DexClient) {
+		c.uploadsURL = uploadsURL
+	}
+}
+
+// WithHTTPClient : Use a custom http.Client for all requests.
+// As this replaces the client, pass it before WithTransport and WithTimeout. A nil client keeps the default client.
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *DexClient) {
+		if client != nil {
+			c.client = client
+		}
+	}
+}
+
+// WithTransport : Use a custom http.RoundTripper for all requests.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(c *DexClient) {
+		// Copy the client so that a client passed to WithHTTPClient is not modified.
+		client := *c.client
+		client.Transport = rt
+		c.client = &client
+	}
+}
+
+// WithTimeout : Set the timeout for each request, including reading the response body.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *DexClient) {
+		// Copy the client so that a client passed to WithHTTPClient is not modified.
+		client := *c.client
+		client.Timeout = timeout
+		c.client = &client
+	}
+}
+
+// WithUserAgent : Set the User-Agent header sent with every API request.
+func WithUserAgent(userAgent string) Option {
+	return WithHeader("User-Agent", userAgent)
+}
+
+// WithHeader : Set a header sent with every API request.
+func WithHeader(key, value string) Option {
+	return func(c *DexClient) {
+		c.header.Set(key, value)
+	}
+}
+
+// WithRateLimiter : Use a custom RateLimiter. A nil RateLimiter disables rate limiting.
+func WithRateLimiter(rl RateLimiter) Option {
+	return func(c *DexClient) {
+		c.limiter = rl
+	}
+}
+
+// WithRetryPolicy : Use a custom RetryPolicy. A nil RetryPolicy disables retries.
+func WithRetryPolicy(p *RetryPolicy) Option {
+	return func(c *DexClient) {
+		c.retry = p
+	}
+}
@@ -0,0 +1,66 @@
+package mangodex
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestOptions(t *testing.T) {
+	var got *http.Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		_, _ = w.Write([]byte(`{"result":"ok","data":{"id":"me"}}`))
+	}))
+	defer srv.Close()
+
+	hc := &http.Client{}
+	dex := NewDexClient(
+		WithBaseURL(srv.URL+"/api/"),
+		WithHTTPClient(hc),
+		WithTimeout(time.Second),
+		WithUserAgent("mangodex-test"),
+		WithHeader("X-Test", "1"),
+		WithRateLimiter(nil),
+	)
+
+	if _, err := dex.User.GetLoggedUser(); err != nil {
+		t.Fatal(err)
+	}
+	if got.URL.Path != "/api/"+GetLoggedUserPath {
+		t.Errorf("expected request relative to base URL, got %s", got.URL.Path)
+	}
+	if got.UserAgent() != "mangodex-test" || got.Header.Get("X-Test") != "1" {
+		t.Errorf("missing configured headers: %v", got.Header)
+	}
+	if hc.Timeout != 0 || dex.client.Timeout != time.Second {
+		t.Errorf("timeout not applied to a copy of the client")
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, dex.buildURL("at-home/server/abc").String(), nil)
+	if r := dex.route(req); r != "at-home/server/abc" {
+		t.Errorf("expected route relative to base URL, got %s", r)
+	}
+}
+
+func TestInvalidBaseURL(t *testing.T) {
+	for _, base := range []string{"://missing-scheme", "api.mangadex.org", "http://[::1"} {
+		dex := NewDexClient(WithBaseURL(base))
+		if _, err := dex.User.GetLoggedUser(); err == nil {
+			t.Errorf("%s: expected an invalid base URL error", base)
+		}
+	}
+}
+
+func TestNilHTTPClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"result":"ok","data":{"id":"me"}}`))
+	}))
+	defer srv.Close()
+
+	dex := NewDexClient(WithBaseURL(srv.URL), WithHTTPClient(nil), WithTimeout(time.Second), WithRateLimiter(nil))
+	if _, err := dex.User.GetLoggedUser(); err != nil {
+		t.Fatal(err)
+	}
+}
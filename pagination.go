@@ -0,0 +1,398 @@
+package mangodex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// MaxPageSize : Maximum number of items the API returns per page.
+	MaxPageSize = 100
+	// MaxOffset : The API refuses requests where offset + limit exceeds this.
+	MaxOffset = 10000
+)
+
+// Fields that deep scans can window on.
+const (
+	ScanByCreatedAt = "createdAt"
+	ScanByUpdatedAt = "updatedAt"
+)
+
+// sinceTimeLayout : Format of the API's createdAtSince and updatedAtSince parameters.
+const sinceTimeLayout = "2006-01-02T15:04:05"
+
+var (
+	// ErrMaxOffset : Returned by iterators reaching MaxOffset. Use a deep scan to iterate further.
+	ErrMaxOffset = errors.New("reached the maximum offset allowed by the API, use DeepScan to iterate further")
+	// ErrDeepScanUnsupported : Returned by iterators over endpoints that cannot be ordered or filtered by timestamp.
+	ErrDeepScanUnsupported = errors.New("deep scans are not supported by this endpoint")
+)
+
+// pageFetcher : Fetch one page of results with the given query parameters,
+// returning the number of items in the page and the total number of items.
+type pageFetcher func(ctx context.Context, params url.Values) (n, total int, err error)
+
+// ScanCursor : Position of a deep scan. Pass it to ResumeFrom to continue the scan later.
+type ScanCursor struct {
+	// Field : Timestamp field the scan is ordered by, ScanByCreatedAt or ScanByUpdatedAt.
+	Field string `json:"field"`
+	// Since : Timestamp of the last returned item.
+	Since string `json:"since"`
+	// Seen : IDs of the returned items with the timestamp Since.
+	Seen []string `json:"seen"`
+}
+
+// pager : Offset pagination state shared by the iterators.
+//
+// In deep scan mode, items are ordered by a timestamp and fetched in windows starting at a timestamp,
+// each paginated by offset. When a window reaches MaxOffset, a new window starts at the timestamp of
+// the last returned item. Items at the window boundary are returned twice by the API, and skipped using the cursor.
+type pager struct {
+	params url.Values
+	limit  int
+	offset int
+	total  int
+	done   bool
+	err    error
+
+	deep        *ScanCursor
+	windowStart string
+	// noDeepScan : Set for endpoints that ignore the order and since parameters deep scans rely on.
+	noDeepScan bool
+}
+
+// newPager : Create a pager for the given query parameters.
+// Pagination starts from the offset in the parameters, if any.
+func newPager(params url.Values) pager {
+	p := pager{params: url.Values{}, limit: MaxPageSize, total: -1}
+	for k, v := range params {
+		p.params[k] = append([]string(nil), v...)
+	}
+	if offset, err := strconv.Atoi(p.params.Get("offset")); err == nil && offset > 0 {
+		p.offset = offset
+	}
+	return p
+}
+
+// setPageSize : Set the number of items fetched per request, clamped between 1 and MaxPageSize.
+func (p *pager) setPageSize(n int) {
+	if n < 1 {
+		n = 1
+	} else if n > MaxPageSize {
+		n = MaxPageSize
+	}
+	p.limit = n
+}
+
+// deepScan : Switch to deep scan mode, ordering by the given timestamp field.
+func (p *pager) deepScan(field string) {
+	p.resumeFrom(ScanCursor{Field: field})
+}
+
+// resumeFrom : Continue a deep scan from a cursor.
+// Fails the iteration with ErrDeepScanUnsupported if the endpoint cannot be deep scanned.
+func (p *pager) resumeFrom(cursor ScanCursor) {
+	if p.noDeepScan {
+		p.err = ErrDeepScanUnsupported
+		return
+	}
+	if cursor.Field != ScanByUpdatedAt {
+		cursor.Field = ScanByCreatedAt
+	}
+	cursor.Seen = append([]string(nil), cursor.Seen...)
+	p.deep = &cursor
+	p.windowStart = cursor.Since
+	if cursor.Since != "" {
+		p.offset = 0
+	}
+
+	// The scan must be ordered by the window field only.
+	for k := range p.params {
+		if strings.HasPrefix(k, "order[") {
+			delete(p.params, k)
+		}
+	}
+	p.params.Set(fmt.Sprintf("order[%s]", cursor.Field), "asc")
+}
+
+// cursor : Get the current position of a deep scan.
+func (p *pager) cursor() ScanCursor {
+	if p.deep == nil {
+		return ScanCursor{}
+	}
+	c := *p.deep
+	c.Seen = append([]string(nil), c.Seen...)
+	return c
+}
+
+// accept : Record an item returned by a deep scan, given its createdAt and updatedAt timestamps.
+// Returns false if the item was already returned.
+func (p *pager) accept(id, createdAt, updatedAt string) bool {
+	if p.deep == nil {
+		return true
+	}
+
+	raw := createdAt
+	if p.deep.Field == ScanByUpdatedAt {
+		raw = updatedAt
+	}
+	ts := sinceTimestamp(raw)
+
+	if ts != p.deep.Since {
+		p.deep.Since = ts
+		p.deep.Seen = nil
+	} else {
+		for _, seen := range p.deep.Seen {
+			if seen == id {
+				return false
+			}
+		}
+	}
+	p.deep.Seen = append(p.deep.Seen, id)
+	return true
+}
+
+// sinceTimestamp : Convert a timestamp returned by the API to the format of the *Since parameters.
+func sinceTimestamp(raw string) string {
+	if t, err := time.Parse(time.RFC3339, raw); err == nil {
+		return t.UTC().Format(sinceTimeLayout)
+	}
+	if len(raw) > len(sinceTimeLayout) {
+		return raw[:len(sinceTimeLayout)]
+	}
+	return raw
+}
+
+// nextPage : Fetch the next page. Returns false when there are no more items or fetching failed.
+func (p *pager) nextPage(ctx context.Context, fetch pageFetcher) bool {
+	if p.done || p.err != nil {
+		return false
+	}
+
+	limit := p.limit
+	if p.deep != nil && p.offset+limit > MaxOffset {
+		// Start a new window at the last returned item.
+		if p.deep.Since == p.windowStart {
+			p.err = fmt.Errorf("deep scan cannot advance: more than %d items have %s %s",
+				MaxOffset, p.deep.Field, p.deep.Since)
+			return false
+		}
+		p.windowStart = p.deep.Since
+		p.offset = 0
+	} else if p.deep == nil {
+		if p.offset >= MaxOffset {
+			p.err = ErrMaxOffset
+			return false
+		}
+		if p.offset+limit > MaxOffset {
+			limit = MaxOffset - p.offset
+		}
+	}
+
+	q := url.Values{}
+	for k, v := range p.params {
+		q[k] = v
+	}
+	q.Set("limit", strconv.Itoa(limit))
+	q.Set("offset", strconv.Itoa(p.offset))
+	if p.deep != nil && p.windowStart != "" {
+		q.Set(p.deep.Field+"Since", p.windowStart)
+	}
+
+	n, total, err := fetch(ctx, q)
+	if err != nil {
+		p.err = err
+		return false
+	}
+	p.offset += n
+	p.total = total
+	if n == 0 || p.offset >= total {
+		p.done = true
+	}
+	return n > 0
+}
+
+// MangaIterator : Lazily iterates over a paginated list of Manga.
+//
+//	it := client.Manga.IterateMangaList(params)
+//	for it.Next(ctx) {
+//		m := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type MangaIterator struct {
+	pager
+	fetch func(ctx context.Context, params url.Values) (*MangaList, error)
+	page  []Manga
+	cur   Manga
+}
+
+func newMangaIterator(params url.Values, fetch func(context.Context, url.Values) (*MangaList, error)) *MangaIterator {
+	return &MangaIterator{pager: newPager(params), fetch: fetch}
+}
+
+// SetPageSize : Set the number of Manga fetched per request. Defaults to MaxPageSize.
+func (it *MangaIterator) SetPageSize(n int) *MangaIterator {
+	it.setPageSize(n)
+	return it
+}
+
+// Next : Advance to the next Manga, fetching the next page when required.
+// Returns false when there are no more Manga or an error occurred.
+func (it *MangaIterator) Next(ctx context.Context) bool {
+	for {
+		for len(it.page) == 0 {
+			ok := it.nextPage(ctx, func(ctx context.Context, params url.Values) (int, int, error) {
+				l, err := it.fetch(ctx, params)
+				if err != nil {
+					return 0, 0, err
+				}
+				it.page = l.Data
+				return len(l.Data), l.Total, nil
+			})
+			if !ok {
+				return false
+			}
+		}
+		it.cur, it.page = it.page[0], it.page[1:]
+		if it.accept(it.cur.ID, it.cur.Attributes.CreatedAt, it.cur.Attributes.UpdatedAt) {
+			return true
+		}
+	}
+}
+
+// DeepScan : Iterate beyond MaxOffset by ordering on a timestamp field, ScanByCreatedAt or ScanByUpdatedAt,
+// and paging through windows of that timestamp. Any other ordering in the parameters is replaced.
+// In deep scan mode, Total reports the number of Manga remaining in the current window.
+// Iteration fails with ErrDeepScanUnsupported for endpoints that cannot be deep scanned.
+func (it *MangaIterator) DeepScan(field string) *MangaIterator {
+	it.deepScan(field)
+	return it
+}
+
+// ResumeFrom : Resume a deep scan from a cursor previously returned by Cursor.
+func (it *MangaIterator) ResumeFrom(cursor ScanCursor) *MangaIterator {
+	it.resumeFrom(cursor)
+	return it
+}
+
+// Cursor : Get the position of a deep scan after the current Manga.
+func (it *MangaIterator) Cursor() ScanCursor {
+	return it.cursor()
+}
+
+// Value : Get the current Manga.
+func (it *MangaIterator) Value() *Manga {
+	return &it.cur
+}
+
+// Err : Get the error that stopped the iteration, if any.
+func (it *MangaIterator) Err() error {
+	return it.err
+}
+
+// Total : Get the total number of Manga reported by the API, or -1 if no page has been fetched yet.
+func (it *MangaIterator) Total() int {
+	return it.total
+}
+
+// CollectAll : Get all remaining Manga, up to max items. A max of 0 or less means no limit.
+func (it *MangaIterator) CollectAll(ctx context.Context, max int) ([]Manga, error) {
+	var all []Manga
+	for (max <= 0 || len(all) < max) && it.Next(ctx) {
+		all = append(all, it.cur)
+	}
+	return all, it.Err()
+}
+
+// ChapterIterator : Lazily iterates over a paginated list of Chapters.
+type ChapterIterator struct {
+	pager
+	fetch func(ctx context.Context, params url.Values) (*ChapterList, error)
+	page  []Chapter
+	cur   Chapter
+}
+
+func newChapterIterator(params url.Values, fetch func(context.Context, url.Values) (*ChapterList, error)) *ChapterIterator {
+	return &ChapterIterator{pager: newPager(params), fetch: fetch}
+}
+
+// SetPageSize : Set the number of Chapters fetched per request. Defaults to MaxPageSize.
+func (it *ChapterIterator) SetPageSize(n int) *ChapterIterator {
+	it.setPageSize(n)
+	return it
+}
+
+// Next : Advance to the next Chapter, fetching the next page when required.
+// Returns false when there are no more Chapters or an error occurred.
+func (it *ChapterIterator) Next(ctx context.Context) bool {
+	for {
+		for len(it.page) == 0 {
+			ok := it.nextPage(ctx, func(ctx context.Context, params url.Values) (int, int, error) {
+				l, err := it.fetch(ctx, params)
+				if err != nil {
+					return 0, 0, err
+				}
+				it.page = l.Data
+				return len(l.Data), l.Total, nil
+			})
+			if !ok {
+				return false
+			}
+		}
+		it.cur, it.page = it.page[0], it.page[1:]
+		if it.accept(it.cur.ID, it.cur.Attributes.CreatedAt, it.cur.Attributes.UpdatedAt) {
+			return true
+		}
+	}
+}
+
+// DeepScan : Iterate beyond MaxOffset by ordering on a timestamp field, ScanByCreatedAt or ScanByUpdatedAt,
+// and paging through windows of that timestamp. Any other ordering in the parameters is replaced.
+// In deep scan mode, Total reports the number of Chapters remaining in the current window.
+// Iteration fails with ErrDeepScanUnsupported for endpoints that cannot be deep scanned.
+func (it *ChapterIterator) DeepScan(field string) *ChapterIterator {
+	it.deepScan(field)
+	return it
+}
+
+// ResumeFrom : Resume a deep scan from a cursor previously returned by Cursor.
+func (it *ChapterIterator) ResumeFrom(cursor ScanCursor) *ChapterIterator {
+	it.resumeFrom(cursor)
+	return it
+}
+
+// Cursor : Get the position of a deep scan after the current Chapter.
+func (it *ChapterIterator) Cursor() ScanCursor {
+	return it.cursor()
+}
+
+// Value : Get the current Chapter.
+func (it *ChapterIterator) Value() *Chapter {
+	return &it.cur
+}
+
+// Err : Get the error that stopped the iteration, if any.
+func (it *ChapterIterator) Err() error {
+	return it.err
+}
+
+// Total : Get the total number of Chapters reported by the API, or -1 if no page has been fetched yet.
+func (it *ChapterIterator) Total() int {
+	return it.total
+}
+
+// CollectAll : Get all remaining Chapters, up to max items. A max of 0 or less means no limit.
+func (it *ChapterIterator) CollectAll(ctx context.Context, max int) ([]Chapter, error) {
+	var all []Chapter
+	for (max <= 0 || len(all) < max) && it.Next(ctx) {
+		all = append(all, it.cur)
+	}
+	return all, it.Err()
+}
@@ -0,0 +1,168 @@
+package mangodex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// pagedMangaHandler : Serve total fake manga, paginated with limit and offset.
+func pagedMangaHandler(total int, requests *int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+		l := MangaList{Result: "ok", Limit: limit, Offset: offset, Total: total}
+		for i := offset; i < offset+limit && i < total; i++ {
+			l.Data = append(l.Data, Manga{ID: fmt.Sprintf("manga-%d", i)})
+		}
+		_ = json.NewEncoder(w).Encode(&l)
+	}
+}
+
+func TestMangaIterator(t *testing.T) {
+	var requests int
+	dex := newTestClient(t, pagedMangaHandler(25, &requests))
+	ctx := context.Background()
+
+	it := dex.Manga.IterateMangaList(url.Values{}).SetPageSize(10)
+	var n int
+	for it.Next(ctx) {
+		if want := fmt.Sprintf("manga-%d", n); it.Value().ID != want {
+			t.Errorf("expected %s, got %s", want, it.Value().ID)
+		}
+		n++
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if n != 25 || requests != 3 || it.Total() != 25 {
+		t.Errorf("expected 25 manga in 3 requests, got %d manga in %d requests", n, requests)
+	}
+
+	// CollectAll stops at the upper bound, and iteration starts at the given offset.
+	all, err := dex.Manga.IterateMangaList(url.Values{"offset": {"5"}}).CollectAll(ctx, 12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 12 || all[0].ID != "manga-5" {
+		t.Errorf("unexpected CollectAll result: %d items starting at %s", len(all), all[0].ID)
+	}
+}
+
+// catalogueHandler : Serve total fake manga created 3 per second, supporting createdAtSince
+// and refusing offsets beyond MaxOffset like the API.
+func catalogueHandler(total int) http.HandlerFunc {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	createdAt := func(i int) time.Time { return start.Add(time.Duration(i/3) * time.Second) }
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		if offset+limit > MaxOffset {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		first := 0
+		if since, err := time.Parse(sinceTimeLayout, q.Get("createdAtSince")); err == nil {
+			for first < total && createdAt(first).Before(since) {
+				first++
+			}
+		}
+
+		l := MangaList{Result: "ok", Limit: limit, Offset: offset, Total: total - first}
+		for i := first + offset; i < first+offset+limit && i < total; i++ {
+			l.Data = append(l.Data, Manga{
+				ID:         fmt.Sprintf("manga-%d", i),
+				Attributes: MangaAttributes{CreatedAt: createdAt(i).Format(time.RFC3339)},
+			})
+		}
+		_ = json.NewEncoder(w).Encode(&l)
+	}
+}
+
+func TestMangaIteratorDeepScan(t *testing.T) {
+	const total = MaxOffset + 500
+	dex := newTestClient(t, catalogueHandler(total))
+	ctx := context.Background()
+
+	// Without a deep scan, iteration stops at the offset cap.
+	_, err := dex.Manga.IterateMangaList(nil).CollectAll(ctx, 0)
+	if !errors.Is(err, ErrMaxOffset) {
+		t.Errorf("expected ErrMaxOffset, got %v", err)
+	}
+
+	// Scan part of the catalogue, then resume from the cursor.
+	it := dex.Manga.IterateMangaList(url.Values{"order[title]": {"asc"}}).DeepScan(ScanByCreatedAt)
+	first, err := it.CollectAll(ctx, 6001)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rest, err := dex.Manga.IterateMangaList(nil).ResumeFrom(it.Cursor()).CollectAll(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	all := append(first, rest...)
+	if len(all) != total {
+		t.Fatalf("expected %d manga, got %d", total, len(all))
+	}
+	for i, m := range all {
+		if want := fmt.Sprintf("manga-%d", i); m.ID != want {
+			t.Fatalf("expected %s at %d, got %s", want, i, m.ID)
+		}
+	}
+}
+
+func TestFollowedMangaFeedIterator(t *testing.T) {
+	dex := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/"+GetUserFollowedMangaFeedPath {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("translatedLanguage[]") != "en" {
+			t.Errorf("missing language filter: %s", r.URL.RawQuery)
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		l := ChapterList{Result: "ok", Offset: offset, Total: 3}
+		for i := offset; i < offset+2 && i < 3; i++ {
+			l.Data = append(l.Data, Chapter{ID: fmt.Sprintf("chapter-%d", i)})
+		}
+		_ = json.NewEncoder(w).Encode(&l)
+	}))
+
+	q := &ChapterFeedQuery{TranslatedLanguage: []string{"en"}}
+	chapters, err := dex.User.IterateFollowedMangaFeed(q).SetPageSize(2).CollectAll(context.Background(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(chapters) != 3 || chapters[2].ID != "chapter-2" {
+		t.Errorf("unexpected chapters %+v", chapters)
+	}
+
+	it := dex.User.IterateFollowedMangaFeed(&ChapterFeedQuery{ContentRating: []string{"unknown"}})
+	if it.Next(context.Background()) || it.Err() == nil {
+		t.Error("expected invalid query to fail the iteration")
+	}
+}
+
+func TestDeepScanUnsupported(t *testing.T) {
+	var requests int
+	dex := newTestClient(t, pagedMangaHandler(25, &requests))
+
+	it := dex.User.IterateUserFollowedMangaList(nil).DeepScan(ScanByCreatedAt)
+	if it.Next(context.Background()) || !errors.Is(it.Err(), ErrDeepScanUnsupported) {
+		t.Errorf("expected ErrDeepScanUnsupported, got %v", it.Err())
+	}
+	if requests != 0 {
+		t.Errorf("expected no requests, got %d", requests)
+	}
+}
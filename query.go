@@ -0,0 +1,594 @@
+package mangodex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	uuidRegex     = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	langCodeRegex = regexp.MustCompile(`^[a-z]{2,3}(-[a-z]{2,})?$`)
+)
+
+// MangaSearchQuery : Typed query for searching Manga. Zero values are not sent.
+// https://api.mangadex.org/docs.html#operation/get-search-manga
+type MangaSearchQuery struct {
+	Limit  int
+	Offset int
+
+	Title   string
+	IDs     []string
+	Authors []string
+	Artists []string
+	Year    int
+
+	IncludedTags     []string
+	IncludedTagsMode string
+	ExcludedTags     []string
+	ExcludedTagsMode string
+	// IncludedTagNames, ExcludedTagNames : Tags given by name instead of ID.
+	// They must be converted to IDs with ResolveTags before the query is used.
+	IncludedTagNames []string
+	ExcludedTagNames []string
+
+	Status                      []string
+	PublicationDemographic      []string
+	ContentRating               []string
+	OriginalLanguage            []string
+	ExcludedOriginalLanguage    []string
+	AvailableTranslatedLanguage []string
+
+	CreatedAtSince time.Time
+	UpdatedAtSince time.Time
+
+	// Order : Map of order field, such as OrderByTitle, to direction, OrderAsc or OrderDesc.
+	Order map[string]string
+	// Includes : Relationship types to expand, such as AuthorRel or CoverArtRel.
+	Includes []string
+}
+
+// Validate : Check that the query only contains values accepted by the API.
+func (q *MangaSearchQuery) Validate() error {
+	checks := []error{
+		checkPage(q.Limit, q.Offset),
+		checkIDs("ids", q.IDs),
+		checkIDs("authors", q.Authors),
+		checkIDs("artists", q.Artists),
+		checkIDs("includedTags", q.IncludedTags),
+		checkIDs("excludedTags", q.ExcludedTags),
+		checkEnum("includedTagsMode", optional(q.IncludedTagsMode), TagsModeAnd, TagsModeOr),
+		checkEnum("excludedTagsMode", optional(q.ExcludedTagsMode), TagsModeAnd, TagsModeOr),
+		checkEnum("status", q.Status, OngoingStatus, CompletedStatus, HiatusStatus, CancelledStatus),
+		checkEnum("publicationDemographic", q.PublicationDemographic,
+			ShonenDemographic, ShoujoDemographic, JoseiDemographic, SeinenDemograpic, NoneDemographic),
+		checkEnum("contentRating", q.ContentRating, Safe, Suggestive, Erotica, Porn),
+		checkLanguages("originalLanguage", q.OriginalLanguage),
+		checkLanguages("excludedOriginalLanguage", q.ExcludedOriginalLanguage),
+		checkLanguages("availableTranslatedLanguage", q.AvailableTranslatedLanguage),
+		checkOrder(q.Order, OrderByTitle, OrderByYear, OrderByCreatedAt, OrderByUpdatedAt,
+			OrderByLatestUploadedChapter, OrderByFollowedCount, OrderByRelevance),
+		checkEnum("includes", q.Includes, MangaRel, AuthorRel, ArtistRel, CoverArtRel),
+	}
+	if q.Year < 0 {
+		checks = append(checks, fmt.Errorf("invalid year %d", q.Year))
+	}
+	if len(q.IncludedTagNames) > 0 || len(q.ExcludedTagNames) > 0 {
+		checks = append(checks, errors.New("tag names must be resolved with ResolveTags"))
+	}
+
+	for _, err := range checks {
+		if err != nil {
+			return fmt.Errorf("invalid manga search query: %s", err.Error())
+		}
+	}
+	return nil
+}
+
+// ResolveTags : Convert IncludedTagNames and ExcludedTagNames to IDs using the resolver,
+// adding them to IncludedTags and ExcludedTags. The names are cleared once resolved.
+func (q *MangaSearchQuery) ResolveTags(ctx context.Context, r *TagResolver) error {
+	included, err := r.Resolve(ctx, q.IncludedTagNames...)
+	if err != nil {
+		return err
+	}
+	excluded, err := r.Resolve(ctx, q.ExcludedTagNames...)
+	if err != nil {
+		return err
+	}
+
+	q.IncludedTags = append(q.IncludedTags, included...)
+	q.ExcludedTags = append(q.ExcludedTags, excluded...)
+	q.IncludedTagNames, q.ExcludedTagNames = nil, nil
+	return nil
+}
+
+// Values : Validate the query and encode it to query parameters. A nil query is treated as an empty query.
+func (q *MangaSearchQuery) Values() (url.Values, error) {
+	if q == nil {
+		q = &MangaSearchQuery{}
+	}
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	setPage(v, q.Limit, q.Offset)
+	setString(v, "title", q.Title)
+	addAll(v, "ids[]", q.IDs)
+	addAll(v, "authors[]", q.Authors)
+	addAll(v, "artists[]", q.Artists)
+	if q.Year > 0 {
+		v.Set("year", strconv.Itoa(q.Year))
+	}
+	addAll(v, "includedTags[]", q.IncludedTags)
+	setString(v, "includedTagsMode", q.IncludedTagsMode)
+	addAll(v, "excludedTags[]", q.ExcludedTags)
+	setString(v, "excludedTagsMode", q.ExcludedTagsMode)
+	addAll(v, "status[]", q.Status)
+	addAll(v, "publicationDemographic[]", q.PublicationDemographic)
+	addAll(v, "contentRating[]", q.ContentRating)
+	addAll(v, "originalLanguage[]", q.OriginalLanguage)
+	addAll(v, "excludedOriginalLanguage[]", q.ExcludedOriginalLanguage)
+	addAll(v, "availableTranslatedLanguage[]", q.AvailableTranslatedLanguage)
+	setTime(v, "createdAtSince", q.CreatedAtSince)
+	setTime(v, "updatedAtSince", q.UpdatedAtSince)
+	setOrder(v, q.Order)
+	addAll(v, "includes[]", q.Includes)
+	return v, nil
+}
+
+// AuthorSearchQuery : Typed query for searching Authors. Zero values are not sent.
+// https://api.mangadex.org/docs.html#operation/get-author
+type AuthorSearchQuery struct {
+	Limit  int
+	Offset int
+
+	Name string
+	IDs  []string
+
+	// Order : Map of order field, OrderByName, to direction, OrderAsc or OrderDesc.
+	Order map[string]string
+	// Includes : Relationship types to expand, such as MangaRel.
+	Includes []string
+}
+
+// Validate : Check that the query only contains values accepted by the API.
+func (q *AuthorSearchQuery) Validate() error {
+	checks := []error{
+		checkPage(q.Limit, q.Offset),
+		checkIDs("ids", q.IDs),
+		checkOrder(q.Order, OrderByName),
+		checkEnum("includes", q.Includes, MangaRel),
+	}
+	for _, err := range checks {
+		if err != nil {
+			return fmt.Errorf("invalid author search query: %s", err.Error())
+		}
+	}
+	return nil
+}
+
+// Values : Validate the query and encode it to query parameters. A nil query is treated as an empty query.
+func (q *AuthorSearchQuery) Values() (url.Values, error) {
+	if q == nil {
+		q = &AuthorSearchQuery{}
+	}
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	setPage(v, q.Limit, q.Offset)
+	setString(v, "name", q.Name)
+	addAll(v, "ids[]", q.IDs)
+	setOrder(v, q.Order)
+	addAll(v, "includes[]", q.Includes)
+	return v, nil
+}
+
+// ScanlationGroupSearchQuery : Typed query for searching Scanlation Groups. Zero values are not sent.
+// https://api.mangadex.org/docs.html#operation/get-search-group
+type ScanlationGroupSearchQuery struct {
+	Limit  int
+	Offset int
+
+	Name            string
+	IDs             []string
+	FocusedLanguage string
+
+	// Order : Map of order field, such as OrderByName, to direction, OrderAsc or OrderDesc.
+	Order map[string]string
+	// Includes : Relationship types to expand, such as LeaderRel or MemberRel.
+	Includes []string
+}
+
+// Validate : Check that the query only contains values accepted by the API.
+func (q *ScanlationGroupSearchQuery) Validate() error {
+	checks := []error{
+		checkPage(q.Limit, q.Offset),
+		checkIDs("ids", q.IDs),
+		checkLanguages("focusedLanguage", optional(q.FocusedLanguage)),
+		checkOrder(q.Order, OrderByName, OrderByCreatedAt, OrderByUpdatedAt, OrderByFollowedCount, OrderByRelevance),
+		checkEnum("includes", q.Includes, LeaderRel, MemberRel),
+	}
+	for _, err := range checks {
+		if err != nil {
+			return fmt.Errorf("invalid scanlation group search query: %s", err.Error())
+		}
+	}
+	return nil
+}
+
+// Values : Validate the query and encode it to query parameters. A nil query is treated as an empty query.
+func (q *ScanlationGroupSearchQuery) Values() (url.Values, error) {
+	if q == nil {
+		q = &ScanlationGroupSearchQuery{}
+	}
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	setPage(v, q.Limit, q.Offset)
+	setString(v, "name", q.Name)
+	addAll(v, "ids[]", q.IDs)
+	setString(v, "focusedLanguage", q.FocusedLanguage)
+	setOrder(v, q.Order)
+	addAll(v, "includes[]", q.Includes)
+	return v, nil
+}
+
+// CoverSearchQuery : Typed query for listing Covers. Zero values are not sent.
+// https://api.mangadex.org/docs.html#operation/get-cover
+type CoverSearchQuery struct {
+	Limit  int
+	Offset int
+
+	Manga     []string
+	IDs       []string
+	Uploaders []string
+	Locales   []string
+
+	// Order : Map of order field, such as OrderByVolume, to direction, OrderAsc or OrderDesc.
+	Order map[string]string
+	// Includes : Relationship types to expand, such as MangaRel or UserRel.
+	Includes []string
+}
+
+// Validate : Check that the query only contains values accepted by the API.
+func (q *CoverSearchQuery) Validate() error {
+	checks := []error{
+		checkPage(q.Limit, q.Offset),
+		checkIDs("manga", q.Manga),
+		checkIDs("ids", q.IDs),
+		checkIDs("uploaders", q.Uploaders),
+		checkLanguages("locales", q.Locales),
+		checkOrder(q.Order, OrderByCreatedAt, OrderByUpdatedAt, OrderByVolume),
+		checkEnum("includes", q.Includes, MangaRel, UserRel),
+	}
+	for _, err := range checks {
+		if err != nil {
+			return fmt.Errorf("invalid cover search query: %s", err.Error())
+		}
+	}
+	return nil
+}
+
+// Values : Validate the query and encode it to query parameters. A nil query is treated as an empty query.
+func (q *CoverSearchQuery) Values() (url.Values, error) {
+	if q == nil {
+		q = &CoverSearchQuery{}
+	}
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	setPage(v, q.Limit, q.Offset)
+	addAll(v, "manga[]", q.Manga)
+	addAll(v, "ids[]", q.IDs)
+	addAll(v, "uploaders[]", q.Uploaders)
+	addAll(v, "locales[]", q.Locales)
+	setOrder(v, q.Order)
+	addAll(v, "includes[]", q.Includes)
+	return v, nil
+}
+
+// ChapterFeedQuery : Typed query for chapter feeds, such as manga feeds, followed feeds and custom list feeds.
+// Zero values are not sent. Some filters, such as Groups, Uploaders, Volumes and Chapters,
+// are only honoured by some feeds.
+// https://api.mangadex.org/docs.html#operation/get-manga-id-feed
+type ChapterFeedQuery struct {
+	Limit  int
+	Offset int
+
+	TranslatedLanguage       []string
+	OriginalLanguage         []string
+	ExcludedOriginalLanguage []string
+	ContentRating            []string
+
+	Groups            []string
+	ExcludedGroups    []string
+	Uploaders         []string
+	ExcludedUploaders []string
+	Volumes           []string
+	Chapters          []string
+
+	// Nil values use the API default.
+	IncludeFutureUpdates   *bool
+	IncludeEmptyPages      *bool
+	IncludeFuturePublishAt *bool
+	IncludeExternalURL     *bool
+
+	CreatedAtSince time.Time
+	UpdatedAtSince time.Time
+	PublishAtSince time.Time
+
+	// Order : Map of order field, such as OrderByChapter, to direction, OrderAsc or OrderDesc.
+	Order map[string]string
+	// Includes : Relationship types to expand, such as MangaRel or ScanlationGroupRel.
+	Includes []string
+}
+
+// Validate : Check that the query only contains values accepted by the API.
+func (q *ChapterFeedQuery) Validate() error {
+	checks := []error{
+		checkPage(q.Limit, q.Offset),
+		checkLanguages("translatedLanguage", q.TranslatedLanguage),
+		checkLanguages("originalLanguage", q.OriginalLanguage),
+		checkLanguages("excludedOriginalLanguage", q.ExcludedOriginalLanguage),
+		checkEnum("contentRating", q.ContentRating, Safe, Suggestive, Erotica, Porn),
+		checkIDs("groups", q.Groups),
+		checkIDs("excludedGroups", q.ExcludedGroups),
+		checkIDs("uploader", q.Uploaders),
+		checkIDs("excludedUploaders", q.ExcludedUploaders),
+		checkOrder(q.Order, OrderByCreatedAt, OrderByUpdatedAt, OrderByPublishAt, OrderByReadableAt,
+			OrderByVolume, OrderByChapter),
+		checkEnum("includes", q.Includes, MangaRel, ScanlationGroupRel, UserRel),
+	}
+	for _, err := range checks {
+		if err != nil {
+			return fmt.Errorf("invalid chapter feed query: %s", err.Error())
+		}
+	}
+	return nil
+}
+
+// Values : Validate the query and encode it to query parameters. A nil query is treated as an empty query.
+func (q *ChapterFeedQuery) Values() (url.Values, error) {
+	if q == nil {
+		q = &ChapterFeedQuery{}
+	}
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	setPage(v, q.Limit, q.Offset)
+	addAll(v, "translatedLanguage[]", q.TranslatedLanguage)
+	addAll(v, "originalLanguage[]", q.OriginalLanguage)
+	addAll(v, "excludedOriginalLanguage[]", q.ExcludedOriginalLanguage)
+	addAll(v, "contentRating[]", q.ContentRating)
+	addAll(v, "groups[]", q.Groups)
+	addAll(v, "excludedGroups[]", q.ExcludedGroups)
+	addAll(v, "uploader[]", q.Uploaders)
+	addAll(v, "excludedUploaders[]", q.ExcludedUploaders)
+	addAll(v, "volume[]", q.Volumes)
+	addAll(v, "chapter[]", q.Chapters)
+	setBool(v, "includeFutureUpdates", q.IncludeFutureUpdates)
+	setBool(v, "includeEmptyPages", q.IncludeEmptyPages)
+	setBool(v, "includeFuturePublishAt", q.IncludeFuturePublishAt)
+	setBool(v, "includeExternalUrl", q.IncludeExternalURL)
+	setTime(v, "createdAtSince", q.CreatedAtSince)
+	setTime(v, "updatedAtSince", q.UpdatedAtSince)
+	setTime(v, "publishAtSince", q.PublishAtSince)
+	setOrder(v, q.Order)
+	addAll(v, "includes[]", q.Includes)
+	return v, nil
+}
+
+// ParseChapterFeedQuery : Decode query parameters into a ChapterFeedQuery.
+// Fails on unknown parameters, so hand-written parameters can be checked for typos.
+func ParseChapterFeedQuery(v url.Values) (*ChapterFeedQuery, error) {
+	q := &ChapterFeedQuery{}
+	lists := map[string]*[]string{
+		"translatedLanguage[]":       &q.TranslatedLanguage,
+		"originalLanguage[]":         &q.OriginalLanguage,
+		"excludedOriginalLanguage[]": &q.ExcludedOriginalLanguage,
+		"contentRating[]":            &q.ContentRating,
+		"groups[]":                   &q.Groups,
+		"excludedGroups[]":           &q.ExcludedGroups,
+		"uploader[]":                 &q.Uploaders,
+		"excludedUploaders[]":        &q.ExcludedUploaders,
+		"volume[]":                   &q.Volumes,
+		"chapter[]":                  &q.Chapters,
+		"includes[]":                 &q.Includes,
+	}
+	bools := map[string]**bool{
+		"includeFutureUpdates":   &q.IncludeFutureUpdates,
+		"includeEmptyPages":      &q.IncludeEmptyPages,
+		"includeFuturePublishAt": &q.IncludeFuturePublishAt,
+		"includeExternalUrl":     &q.IncludeExternalURL,
+	}
+	times := map[string]*time.Time{
+		"createdAtSince": &q.CreatedAtSince,
+		"updatedAtSince": &q.UpdatedAtSince,
+		"publishAtSince": &q.PublishAtSince,
+	}
+
+	var err error
+	for key, values := range v {
+		if len(values) == 0 {
+			// Keys without values are not sent by url.Values.Encode either.
+			continue
+		}
+		value := values[0]
+		if l, ok := lists[key]; ok {
+			*l = append([]string(nil), values...)
+		} else if b, ok := bools[key]; ok {
+			*b, err = parseBool(value)
+		} else if t, ok := times[key]; ok {
+			*t, err = time.ParseInLocation(sinceTimeLayout, value, time.UTC)
+		} else if key == "limit" {
+			q.Limit, err = strconv.Atoi(value)
+		} else if key == "offset" {
+			q.Offset, err = strconv.Atoi(value)
+		} else if field, ok := orderField(key); ok {
+			if q.Order == nil {
+				q.Order = map[string]string{}
+			}
+			q.Order[field] = value
+		} else {
+			err = fmt.Errorf("unknown parameter")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid chapter feed parameter %s=%q: %s", key, value, err.Error())
+		}
+	}
+	return q, q.Validate()
+}
+
+// optional : Wrap a single optional value for validation.
+func optional(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return []string{value}
+}
+
+// checkPage : Check limit and offset against the API's bounds.
+func checkPage(limit, offset int) error {
+	if limit < 0 || limit > MaxPageSize {
+		return fmt.Errorf("limit %d not between 0 and %d", limit, MaxPageSize)
+	}
+	if limit == 0 {
+		limit = 10 // Default page size of the API.
+	}
+	if offset < 0 || offset+limit > MaxOffset {
+		return fmt.Errorf("offset %d with limit %d exceeds %d", offset, limit, MaxOffset)
+	}
+	return nil
+}
+
+// checkEnum : Check that all values are one of the allowed values.
+func checkEnum(name string, values []string, allowed ...string) error {
+	for _, v := range values {
+		found := false
+		for _, a := range allowed {
+			if v == a {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("unknown %s %q", name, v)
+		}
+	}
+	return nil
+}
+
+// checkIDs : Check that all values are UUIDs.
+func checkIDs(name string, ids []string) error {
+	for _, id := range ids {
+		if !uuidRegex.MatchString(id) {
+			return fmt.Errorf("%s: %q is not a UUID", name, id)
+		}
+	}
+	return nil
+}
+
+// checkLanguages : Check that all values look like language codes, such as "en" or "pt-br".
+func checkLanguages(name string, langs []string) error {
+	for _, l := range langs {
+		if !langCodeRegex.MatchString(l) {
+			return fmt.Errorf("%s: %q is not a language code", name, l)
+		}
+	}
+	return nil
+}
+
+// checkOrder : Check the order fields and directions.
+func checkOrder(order map[string]string, fields ...string) error {
+	for field, dir := range order {
+		if err := checkEnum("order field", []string{field}, fields...); err != nil {
+			return err
+		}
+		if err := checkEnum("order direction", []string{dir}, OrderAsc, OrderDesc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setPage : Set limit and offset, if not zero.
+func setPage(v url.Values, limit, offset int) {
+	if limit > 0 {
+		v.Set("limit", strconv.Itoa(limit))
+	}
+	if offset > 0 {
+		v.Set("offset", strconv.Itoa(offset))
+	}
+}
+
+// setString : Set a parameter, if not empty.
+func setString(v url.Values, key, value string) {
+	if value != "" {
+		v.Set(key, value)
+	}
+}
+
+// setTime : Set a timestamp parameter in the format expected by the API, if not zero.
+func setTime(v url.Values, key string, t time.Time) {
+	if !t.IsZero() {
+		v.Set(key, t.UTC().Format(sinceTimeLayout))
+	}
+}
+
+// setOrder : Set order[field] parameters.
+func setOrder(v url.Values, order map[string]string) {
+	for field, dir := range order {
+		v.Set(fmt.Sprintf("order[%s]", field), dir)
+	}
+}
+
+// setBool : Set a 0/1 flag parameter, if not nil.
+func setBool(v url.Values, key string, b *bool) {
+	if b == nil {
+		return
+	}
+	if *b {
+		v.Set(key, "1")
+	} else {
+		v.Set(key, "0")
+	}
+}
+
+// parseBool : Parse a 0/1 flag parameter.
+func parseBool(value string) (*bool, error) {
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// orderField : Get the field of an order[field] parameter.
+func orderField(key string) (string, bool) {
+	if strings.HasPrefix(key, "order[") && strings.HasSuffix(key, "]") {
+		return key[len("order[") : len(key)-1], true
+	}
+	return "", false
+}
+
+// addAll : Add each value of an array parameter.
+func addAll(v url.Values, key string, values []string) {
+	for _, value := range values {
+		v.Add(key, value)
+	}
+}
@@ -0,0 +1,137 @@
+package mangodex
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+const testUUID = "a96676e5-8ae2-425e-b549-7f15dd34a6d8"
+
+func TestMangaSearchQueryValues(t *testing.T) {
+	q := &MangaSearchQuery{
+		Limit:            50,
+		Title:            "Komi",
+		Authors:          []string{testUUID},
+		Year:             2016,
+		IncludedTags:     []string{testUUID, testUUID},
+		IncludedTagsMode: TagsModeOr,
+		Status:           []string{OngoingStatus, CompletedStatus},
+		ContentRating:    []string{Safe},
+		CreatedAtSince:   time.Date(2021, 5, 1, 12, 0, 0, 0, time.FixedZone("UTC+2", 2*3600)),
+		Order:            map[string]string{OrderByFollowedCount: OrderDesc},
+		Includes:         []string{AuthorRel, CoverArtRel},
+	}
+	v, err := q.Values()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := url.Values{
+		"limit":                {"50"},
+		"title":                {"Komi"},
+		"authors[]":            {testUUID},
+		"year":                 {"2016"},
+		"includedTags[]":       {testUUID, testUUID},
+		"includedTagsMode":     {"OR"},
+		"status[]":             {"ongoing", "completed"},
+		"contentRating[]":      {"safe"},
+		"createdAtSince":       {"2021-05-01T10:00:00"},
+		"order[followedCount]": {"desc"},
+		"includes[]":           {"author", "cover_art"},
+	}
+	if v.Encode() != want.Encode() {
+		t.Errorf("expected %s, got %s", want.Encode(), v.Encode())
+	}
+}
+
+func TestSearchManga(t *testing.T) {
+	var got url.Values
+	dex := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.URL.Query()
+		_, _ = w.Write([]byte(`{"result":"ok","data":[],"total":0}`))
+	}))
+
+	q := &MangaSearchQuery{
+		Limit: 100,
+		// Include Author and Artist relationships
+		Includes: []string{AuthorRel, ArtistRel},
+	}
+	if _, err := dex.Manga.SearchManga(q); err != nil {
+		t.Fatal(err)
+	}
+	if got.Get("limit") != "100" || !reflect.DeepEqual(got["includes[]"], []string{AuthorRel, ArtistRel}) {
+		t.Errorf("unexpected query %v", got)
+	}
+
+	if _, err := dex.Manga.SearchManga(&MangaSearchQuery{Limit: -1}); err == nil {
+		t.Error("expected invalid query to fail")
+	}
+}
+
+func TestMangaSearchQueryValidate(t *testing.T) {
+	invalid := []*MangaSearchQuery{
+		{Limit: 101},
+		{Offset: MaxOffset},
+		{Status: []string{"finished"}},
+		{IncludedTags: []string{"Romance"}},
+		{IncludedTagsMode: "XOR"},
+		{OriginalLanguage: []string{"Japanese"}},
+		{Order: map[string]string{"rating": OrderAsc}},
+		{Order: map[string]string{OrderByTitle: "up"}},
+		{Includes: []string{ChapterRel}},
+	}
+	for _, q := range invalid {
+		if _, err := q.Values(); err == nil {
+			t.Errorf("expected validation error for %+v", q)
+		}
+	}
+}
+
+func TestChapterFeedQueryRoundTrip(t *testing.T) {
+	yes, no := true, false
+	q := &ChapterFeedQuery{
+		Limit:                100,
+		Offset:               200,
+		TranslatedLanguage:   []string{"en", "pt-br"},
+		ContentRating:        []string{Safe, Suggestive},
+		ExcludedGroups:       []string{testUUID},
+		Volumes:              []string{"1", "none"},
+		IncludeFutureUpdates: &no,
+		IncludeExternalURL:   &yes,
+		PublishAtSince:       time.Date(2021, 12, 1, 8, 30, 0, 0, time.UTC),
+		Order:                map[string]string{OrderByVolume: OrderAsc, OrderByChapter: OrderAsc},
+		Includes:             []string{ScanlationGroupRel},
+	}
+
+	// Encode, send to the feed endpoint, and decode on the server side.
+	var got *ChapterFeedQuery
+	dex := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		if got, err = ParseChapterFeedQuery(r.URL.Query()); err != nil {
+			t.Error(err)
+		}
+		_, _ = w.Write([]byte(`{"result":"ok","data":[]}`))
+	}))
+	if _, err := dex.Chapter.GetMangaFeed(testUUID, q); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(q, got) {
+		t.Errorf("round trip mismatch:\nsent %+v\ngot  %+v", q, got)
+	}
+
+	// Typos in hand-written parameters are reported.
+	if _, err := ParseChapterFeedQuery(url.Values{"translatedLanguages[]": {"en"}}); err == nil {
+		t.Error("expected error for unknown parameter")
+	}
+	// Keys without values are ignored.
+	if got, err := ParseChapterFeedQuery(url.Values{"limit": {}, "translatedLanguage[]": {"en"}}); err != nil ||
+		got.Limit != 0 || len(got.TranslatedLanguage) != 1 {
+		t.Errorf("unexpected query %+v: %v", got, err)
+	}
+	if _, err := (&ChapterFeedQuery{Order: map[string]string{OrderByTitle: OrderAsc}}).Values(); err == nil {
+		t.Error("expected error for invalid order field")
+	}
+}
@@ -0,0 +1,244 @@
+package mangodex
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimiter : Limits the rate of requests a DexClient sends to the API.
+// Routes are API paths without the leading slash, such as "at-home/server/{id}".
+type RateLimiter interface {
+	// Wait : Block until a request may be sent to the route, or the context is done.
+	Wait(ctx context.Context, method, route string) error
+	// Update : Adapt the limiter to the rate limit headers of a response from the route.
+	Update(method, route string, header http.Header)
+}
+
+// RateLimitRule : Request budget for routes matching a pattern.
+type RateLimitRule struct {
+	// Method : HTTP method the rule applies to. Empty matches all methods.
+	Method string
+	// Pattern : path.Match pattern for the route, such as "at-home/server/*".
+	Pattern string
+	// Limit : Number of requests allowed every Per.
+	Limit int
+	Per   time.Duration
+}
+
+// matches : Check if the rule applies to a request.
+func (r RateLimitRule) matches(method, route string) bool {
+	if r.Method != "" && r.Method != method {
+		return false
+	}
+	ok, _ := path.Match(r.Pattern, route)
+	return ok
+}
+
+// DefaultRateLimitRules : Per-route limits documented by MangaDex.
+// https://api.mangadex.org/docs.html#section/Rate-limits
+func DefaultRateLimitRules() []RateLimitRule {
+	return []RateLimitRule{
+		{Method: http.MethodPost, Pattern: LoginPath, Limit: 30, Per: time.Hour},
+		{Method: http.MethodPost, Pattern: RefreshTokenPath, Limit: 60, Per: time.Hour},
+		{Method: http.MethodGet, Pattern: "at-home/server/*", Limit: 40, Per: time.Minute},
+		{Method: http.MethodPost, Pattern: "manga", Limit: 10, Per: time.Hour},
+		{Method: http.MethodPut, Pattern: "manga/*", Limit: 10, Per: time.Minute},
+		{Method: http.MethodDelete, Pattern: "manga/*", Limit: 10, Per: 10 * time.Minute},
+		{Method: http.MethodPost, Pattern: "group", Limit: 10, Per: time.Hour},
+		{Method: http.MethodPut, Pattern: "group/*", Limit: 10, Per: time.Minute},
+		{Method: http.MethodDelete, Pattern: "group/*", Limit: 10, Per: 10 * time.Minute},
+		{Method: http.MethodPost, Pattern: "author", Limit: 10, Per: time.Hour},
+		{Method: http.MethodPut, Pattern: "author/*", Limit: 10, Per: time.Minute},
+		{Method: http.MethodDelete, Pattern: "author/*", Limit: 10, Per: 10 * time.Minute},
+		{Method: http.MethodPut, Pattern: "chapter/*", Limit: 10, Per: time.Minute},
+		{Method: http.MethodDelete, Pattern: "chapter/*", Limit: 10, Per: time.Minute},
+	}
+}
+
+// BucketRateLimiter : Token bucket RateLimiter with a global budget and per-route budgets.
+// A request must fit in both the global budget and the budget of the first rule matching its route.
+type BucketRateLimiter struct {
+	global  *bucket
+	rules   []RateLimitRule
+	buckets []*bucket
+}
+
+// NewBucketRateLimiter : Create a BucketRateLimiter allowing limit requests every per, with additional per-route rules.
+// A limit or per of zero or less disables the global budget, and rules with a Limit or Per of zero or less are ignored.
+func NewBucketRateLimiter(limit int, per time.Duration, rules ...RateLimitRule) *BucketRateLimiter {
+	l := &BucketRateLimiter{}
+	if limit > 0 && per > 0 {
+		l.global = newBucket(limit, per)
+	}
+	for _, r := range rules {
+		if r.Limit <= 0 || r.Per <= 0 {
+			continue
+		}
+		l.rules = append(l.rules, r)
+		l.buckets = append(l.buckets, newBucket(r.Limit, r.Per))
+	}
+	return l
+}
+
+// NewDefaultRateLimiter : Create a BucketRateLimiter with the global limit of 5 requests per second
+// and the DefaultRateLimitRules.
+func NewDefaultRateLimiter() *BucketRateLimiter {
+	return NewBucketRateLimiter(5, time.Second, DefaultRateLimitRules()...)
+}
+
+// routeBucket : Get the bucket of the first rule matching the request, if any.
+func (l *BucketRateLimiter) routeBucket(method, route string) *bucket {
+	for i, r := range l.rules {
+		if r.matches(method, route) {
+			return l.buckets[i]
+		}
+	}
+	return nil
+}
+
+// Wait : Block until a request may be sent to the route, or the context is done.
+func (l *BucketRateLimiter) Wait(ctx context.Context, method, route string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// Wait on the route first, so global tokens are not used up while waiting for a slow route.
+	b := l.routeBucket(method, route)
+	if b != nil {
+		if err := b.wait(ctx); err != nil {
+			return err
+		}
+	}
+	if l.global == nil {
+		return nil
+	}
+	if err := l.global.wait(ctx); err != nil {
+		// The request is not sent, so give back the route token.
+		if b != nil {
+			b.release()
+		}
+		return err
+	}
+	return nil
+}
+
+// Update : Adapt to the X-RateLimit-Remaining and X-RateLimit-Retry-After headers of a response.
+// The headers apply to the route's bucket, or the global bucket if no rule matches the route.
+func (l *BucketRateLimiter) Update(method, route string, header http.Header) {
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	var retryAfter time.Time
+	if ts, err := strconv.ParseInt(header.Get("X-RateLimit-Retry-After"), 10, 64); err == nil {
+		retryAfter = time.Unix(ts, 0)
+	}
+
+	b := l.routeBucket(method, route)
+	if b == nil {
+		b = l.global
+	}
+	if b != nil {
+		b.update(remaining, retryAfter)
+	}
+}
+
+// bucket : A token bucket, refilled continuously at rate tokens per second up to capacity.
+type bucket struct {
+	mu           sync.Mutex
+	capacity     float64
+	rate         float64
+	tokens       float64
+	last         time.Time
+	blockedUntil time.Time
+}
+
+func newBucket(limit int, per time.Duration) *bucket {
+	return &bucket{
+		capacity: float64(limit),
+		rate:     float64(limit) / per.Seconds(),
+		tokens:   float64(limit),
+		last:     time.Now(),
+	}
+}
+
+// refill : Add the tokens accumulated since the last refill. Must be called with mu held.
+func (b *bucket) refill(now time.Time) {
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.capacity {
+		b.tokens = b.capacity
+	}
+	b.last = now
+}
+
+// reserve : Take a token, or return how long to wait before trying again.
+func (b *bucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if now.Before(b.blockedUntil) {
+		return b.blockedUntil.Sub(now)
+	}
+	b.refill(now)
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
+
+// wait : Block until a token is taken from the bucket, or the context is done.
+func (b *bucket) wait(ctx context.Context) error {
+	for {
+		d := b.reserve(time.Now())
+		if d <= 0 {
+			return nil
+		}
+		if err := sleepContext(ctx, d); err != nil {
+			return err
+		}
+	}
+}
+
+// release : Return a token taken by reserve that was not used.
+func (b *bucket) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(time.Now())
+	if b.tokens++; b.tokens > b.capacity {
+		b.tokens = b.capacity
+	}
+}
+
+// update : Lower the available tokens to what the server reports as remaining,
+// and block the bucket until retryAfter when nothing remains.
+func (b *bucket) update(remaining int, retryAfter time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(time.Now())
+	if r := float64(remaining); r < b.tokens {
+		b.tokens = r
+	}
+	if remaining <= 0 && retryAfter.After(b.blockedUntil) {
+		b.blockedUntil = retryAfter
+	}
+}
+
+// sleepContext : Sleep for d, returning early with the context error if the context is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,95 @@
+package mangodex
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBucketRateLimiter(t *testing.T) {
+	l := NewBucketRateLimiter(100, time.Second, RateLimitRule{
+		Method: http.MethodGet, Pattern: "at-home/server/*", Limit: 2, Per: time.Hour,
+	})
+	ctx := context.Background()
+
+	// The route budget allows two requests, the third must block until the context is done.
+	for i := 0; i < 2; i++ {
+		if err := l.Wait(ctx, http.MethodGet, "at-home/server/abc"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	short, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(short, http.MethodGet, "at-home/server/abc"); err != context.DeadlineExceeded {
+		t.Errorf("expected deadline exceeded, got %v", err)
+	}
+
+	// Other routes only use the global budget.
+	if err := l.Wait(ctx, http.MethodGet, "manga"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Headers reporting no remaining requests block the global budget until the retry time.
+	header := http.Header{}
+	header.Set("X-RateLimit-Remaining", "0")
+	header.Set("X-RateLimit-Retry-After", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+	l.Update(http.MethodGet, "manga", header)
+
+	short, cancel = context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(short, http.MethodGet, "manga"); err != context.DeadlineExceeded {
+		t.Errorf("expected deadline exceeded after rate limit headers, got %v", err)
+	}
+}
+
+func TestBucketRateLimiterRouteTokenReturned(t *testing.T) {
+	l := NewBucketRateLimiter(1, time.Hour,
+		RateLimitRule{Pattern: "manga", Limit: 0, Per: time.Second},
+		RateLimitRule{Pattern: "at-home/server/*", Limit: 2, Per: time.Hour},
+	)
+	ctx := context.Background()
+
+	// The invalid rule is ignored, so this request only takes the global token.
+	if err := l.Wait(ctx, http.MethodGet, "manga"); err != nil {
+		t.Fatal(err)
+	}
+	if len(l.rules) != 1 {
+		t.Fatalf("expected the invalid rule to be ignored, got %v", l.rules)
+	}
+
+	// The global budget is used up, so the route token must be given back when the wait fails.
+	short, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(short, http.MethodGet, "at-home/server/abc"); err != context.DeadlineExceeded {
+		t.Errorf("expected deadline exceeded, got %v", err)
+	}
+	if b := l.buckets[0]; b.reserve(time.Now()) != 0 || b.reserve(time.Now()) != 0 {
+		t.Error("expected the route token to be returned")
+	}
+}
+
+// TestSetRateLimiterConcurrently : Run with -race to check the limiter can be replaced during requests.
+func TestSetRateLimiterConcurrently(t *testing.T) {
+	dex := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"result":"ok"}`))
+	}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if _, err := dex.User.GetLoggedUser(); err != nil {
+				t.Error(err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			dex.SetRateLimiter(NewBucketRateLimiter(100, time.Millisecond))
+		}()
+	}
+	wg.Wait()
+}
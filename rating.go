@@ -0,0 +1,120 @@
+package mangodex
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	RatingListPath = "rating"
+	RatingPath     = "rating/%s"
+
+	MinRating = 1
+	MaxRating = 10
+)
+
+// RatingService : Provides rating services provided by the API.
+type RatingService service
+
+// RatingList : A response for getting the logged in user's ratings.
+type RatingList struct {
+	Result string `json:"result"`
+	// Ratings : Map of Manga ID to the user's rating.
+	Ratings map[string]Rating `json:"ratings"`
+}
+
+func (rl *RatingList) GetResult() string {
+	return rl.Result
+}
+
+func (rl *RatingList) UnmarshalJSON(data []byte) error {
+	raw := struct {
+		Result  string          `json:"result"`
+		Ratings json.RawMessage `json:"ratings"`
+	}{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	rl.Result = raw.Result
+	rl.Ratings = map[string]Rating{}
+	return unmarshalMap(raw.Ratings, &rl.Ratings)
+}
+
+// Rating : A user's rating of a Manga.
+type Rating struct {
+	Rating    int    `json:"rating"`
+	CreatedAt string `json:"createdAt"`
+}
+
+// GetRatings : Get the logged in user's ratings of the given Manga, as a map of Manga ID to rating.
+// Manga the user has not rated are omitted. Large sets of IDs are split over several requests.
+// https://api.mangadex.org/docs.html#operation/get-rating
+func (s *RatingService) GetRatings(mangaIDs []string) (map[string]Rating, error) {
+	return s.GetRatingsContext(context.Background(), mangaIDs)
+}
+
+// GetRatingsContext : GetRatings with custom context.
+func (s *RatingService) GetRatingsContext(ctx context.Context, mangaIDs []string) (map[string]Rating, error) {
+	if err := checkIDs("manga", mangaIDs); err != nil {
+		return nil, fmt.Errorf("invalid rating query: %s", err.Error())
+	}
+
+	ratings := map[string]Rating{}
+	for _, chunk := range chunkIDs(mangaIDs, maxIDsPerRequest) {
+		u := s.client.buildURL(RatingListPath)
+		q := u.Query()
+		addAll(q, "manga[]", chunk)
+		u.RawQuery = q.Encode()
+
+		var l RatingList
+		if err := s.client.RequestAndDecode(ctx, http.MethodGet, u.String(), nil, &l); err != nil {
+			return nil, err
+		}
+		for id, r := range l.Ratings {
+			ratings[id] = r
+		}
+	}
+	return ratings, nil
+}
+
+// SetRating : Rate a Manga, from MinRating to MaxRating, as the logged in user.
+// https://api.mangadex.org/docs.html#operation/post-rating-manga-id
+func (s *RatingService) SetRating(mangaID string, rating int) (*Response, error) {
+	return s.SetRatingContext(context.Background(), mangaID, rating)
+}
+
+// SetRatingContext : SetRating with custom context.
+func (s *RatingService) SetRatingContext(ctx context.Context, mangaID string, rating int) (*Response, error) {
+	if rating < MinRating || rating > MaxRating {
+		return nil, fmt.Errorf("rating %d not between %d and %d", rating, MinRating, MaxRating)
+	}
+	u := s.client.buildURL(fmt.Sprintf(RatingPath, mangaID))
+
+	rBytes, err := json.Marshal(map[string]int{"rating": rating})
+	if err != nil {
+		return nil, err
+	}
+
+	var r Response
+	err = s.client.RequestAndDecode(ctx, http.MethodPost, u.String(), bytes.NewBuffer(rBytes), &r)
+	return &r, err
+}
+
+// DeleteRating : Remove the logged in user's rating of a Manga.
+// https://api.mangadex.org/docs.html#operation/delete-rating-manga-id
+func (s *RatingService) DeleteRating(mangaID string) (*Response, error) {
+	return s.DeleteRatingContext(context.Background(), mangaID)
+}
+
+// DeleteRatingContext : DeleteRating with custom context.
+func (s *RatingService) DeleteRatingContext(ctx context.Context, mangaID string) (*Response, error) {
+	u := s.client.buildURL(fmt.Sprintf(RatingPath, mangaID))
+
+	var r Response
+	err := s.client.RequestAndDecode(ctx, http.MethodDelete, u.String(), nil, &r)
+	return &r, err
+}
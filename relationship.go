@@ -0,0 +1,93 @@
+package mangodex
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+// relationshipTypes : Constructors for the attributes of each relationship type, guarded by relationshipTypesMu.
+var (
+	relationshipTypesMu sync.RWMutex
+	relationshipTypes   = map[string]func() interface{}{
+		MangaRel:           func() interface{} { return &MangaAttributes{} },
+		ChapterRel:         func() interface{} { return &ChapterAttributes{} },
+		CoverArtRel:        func() interface{} { return &CoverAttributes{} },
+		AuthorRel:          func() interface{} { return &AuthorAttributes{} },
+		ArtistRel:          func() interface{} { return &AuthorAttributes{} },
+		ScanlationGroupRel: func() interface{} { return &ScanlationGroupAttributes{} },
+		TagRel:             func() interface{} { return &TagAttributes{} },
+		UserRel:            func() interface{} { return &UserAttributes{} },
+		CustomListRel:      func() interface{} { return &CustomListAttributes{} },
+		LeaderRel:          func() interface{} { return &UserAttributes{} },
+		MemberRel:          func() interface{} { return &UserAttributes{} },
+	}
+)
+
+// RegisterRelationshipType : Decode the attributes of relationships of a type into values created by newAttributes,
+// which must return a pointer. Use this for relationship types the library does not know about yet.
+// Registering a known type replaces its decoder.
+func RegisterRelationshipType(typ string, newAttributes func() interface{}) {
+	relationshipTypesMu.Lock()
+	defer relationshipTypesMu.Unlock()
+	relationshipTypes[typ] = newAttributes
+}
+
+// newRelationshipAttributes : Create a value to decode the attributes of a relationship type into.
+func newRelationshipAttributes(typ string) interface{} {
+	relationshipTypesMu.RLock()
+	newAttributes, ok := relationshipTypes[typ]
+	relationshipTypesMu.RUnlock()
+	if !ok {
+		return &json.RawMessage{}
+	}
+	return newAttributes()
+}
+
+// AsManga : Get the attributes of a manga relationship. Returns false if the relationship
+// is of another type or was not expanded.
+func (a *Relationship) AsManga() (*MangaAttributes, bool) {
+	attrs, ok := a.Attributes.(*MangaAttributes)
+	return attrs, ok && a.Type == MangaRel
+}
+
+// AsChapter : Get the attributes of a chapter relationship.
+func (a *Relationship) AsChapter() (*ChapterAttributes, bool) {
+	attrs, ok := a.Attributes.(*ChapterAttributes)
+	return attrs, ok && a.Type == ChapterRel
+}
+
+// AsCoverArt : Get the attributes of a cover art relationship.
+func (a *Relationship) AsCoverArt() (*CoverAttributes, bool) {
+	attrs, ok := a.Attributes.(*CoverAttributes)
+	return attrs, ok && a.Type == CoverArtRel
+}
+
+// AsAuthor : Get the attributes of an author or artist relationship.
+func (a *Relationship) AsAuthor() (*AuthorAttributes, bool) {
+	attrs, ok := a.Attributes.(*AuthorAttributes)
+	return attrs, ok && (a.Type == AuthorRel || a.Type == ArtistRel)
+}
+
+// AsScanlationGroup : Get the attributes of a scanlation group relationship.
+func (a *Relationship) AsScanlationGroup() (*ScanlationGroupAttributes, bool) {
+	attrs, ok := a.Attributes.(*ScanlationGroupAttributes)
+	return attrs, ok && a.Type == ScanlationGroupRel
+}
+
+// AsTag : Get the attributes of a tag relationship.
+func (a *Relationship) AsTag() (*TagAttributes, bool) {
+	attrs, ok := a.Attributes.(*TagAttributes)
+	return attrs, ok && a.Type == TagRel
+}
+
+// AsUser : Get the attributes of a user, leader or member relationship.
+func (a *Relationship) AsUser() (*UserAttributes, bool) {
+	attrs, ok := a.Attributes.(*UserAttributes)
+	return attrs, ok && (a.Type == UserRel || a.Type == LeaderRel || a.Type == MemberRel)
+}
+
+// AsCustomList : Get the attributes of a custom list relationship.
+func (a *Relationship) AsCustomList() (*CustomListAttributes, bool) {
+	attrs, ok := a.Attributes.(*CustomListAttributes)
+	return attrs, ok && a.Type == CustomListRel
+}
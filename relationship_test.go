@@ -0,0 +1,136 @@
+package mangodex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestRelationshipDecoding(t *testing.T) {
+	var rels []Relationship
+	err := json.Unmarshal([]byte(`[
+		{"id":"a1","type":"artist","attributes":{"name":"Artist"}},
+		{"id":"c1","type":"chapter","attributes":{"chapter":"3"}},
+		{"id":"t1","type":"tag","attributes":{"name":{"en":"Action"},"group":"genre"}},
+		{"id":"u1","type":"user"},
+		{"id":"x1","type":"creator","attributes":{"name":"Someone"}}
+	]`), &rels)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if attrs, ok := rels[0].AsAuthor(); !ok || attrs.Name != "Artist" {
+		t.Errorf("unexpected artist %+v", rels[0])
+	}
+	if attrs, ok := rels[1].AsChapter(); !ok || attrs.Chapter == nil || *attrs.Chapter != "3" {
+		t.Errorf("unexpected chapter %+v", rels[1])
+	}
+	if attrs, ok := rels[2].AsTag(); !ok || attrs.Group != GenreTagGroup {
+		t.Errorf("unexpected tag %+v", rels[2])
+	}
+	if _, ok := rels[2].AsAuthor(); ok {
+		t.Error("expected tag not to be an author")
+	}
+	if _, ok := rels[3].AsUser(); ok || rels[3].Attributes != nil {
+		t.Errorf("expected unexpanded user to have no attributes, got %+v", rels[3].Attributes)
+	}
+	if _, ok := rels[4].Attributes.(*json.RawMessage); !ok {
+		t.Errorf("expected unknown type to decode to json.RawMessage, got %T", rels[4].Attributes)
+	}
+
+	type creatorAttributes struct {
+		Name string `json:"name"`
+	}
+	RegisterRelationshipType("creator", func() interface{} { return &creatorAttributes{} })
+	defer func() {
+		relationshipTypesMu.Lock()
+		delete(relationshipTypes, "creator")
+		relationshipTypesMu.Unlock()
+	}()
+	var rel Relationship
+	if err = json.Unmarshal([]byte(`{"id":"x1","type":"creator","attributes":{"name":"Someone"}}`), &rel); err != nil {
+		t.Fatal(err)
+	}
+	if attrs, ok := rel.Attributes.(*creatorAttributes); !ok || attrs.Name != "Someone" {
+		t.Errorf("unexpected registered attributes %+v", rel.Attributes)
+	}
+}
+
+func TestEntityAccessorsAndHydrate(t *testing.T) {
+	const (
+		authorID = "00000000-0000-0000-0000-00000000000a"
+		groupID  = "00000000-0000-0000-0000-00000000000b"
+		sequelID = "00000000-0000-0000-0000-00000000000c"
+	)
+	var m Manga
+	err := json.Unmarshal([]byte(`{"id":"m1","type":"manga","relationships":[
+		{"id":"`+authorID+`","type":"author"},
+		{"id":"`+authorID+`","type":"artist"},
+		{"id":"c1","type":"cover_art","attributes":{"fileName":"cover.jpg"}},
+		{"id":"`+sequelID+`","type":"manga","related":"sequel"}
+	]}`), &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if authors, ok := m.Authors(); ok || len(authors) != 1 || authors[0].ID != authorID {
+		t.Errorf("expected one unexpanded author, got %+v", authors)
+	}
+	if cover, ok := m.CoverArt(); !ok || cover.Attributes.FileName != "cover.jpg" {
+		t.Errorf("unexpected cover %+v", cover)
+	}
+	if related, ok := m.RelatedManga(); ok || len(related) != 1 || related[0].Related != "sequel" {
+		t.Errorf("unexpected related manga %+v", related)
+	}
+
+	var requests int
+	dex := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch r.URL.Path {
+		case "/" + AuthorListPath:
+			if ids := r.URL.Query()["ids[]"]; len(ids) != 1 || ids[0] != authorID {
+				t.Errorf("unexpected author IDs %v", ids)
+			}
+			_, _ = w.Write([]byte(`{"result":"ok","data":[{"id":"` + authorID + `","type":"author","attributes":{"name":"Writer"}}]}`))
+		case "/" + MangaListPath:
+			if len(r.URL.Query()["contentRating[]"]) != 4 {
+				t.Errorf("expected all content ratings, got %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{"result":"ok","data":[{"id":"` + sequelID + `","type":"manga","attributes":{"year":2021}}]}`))
+		case "/" + ScanlationGroupListPath:
+			_, _ = w.Write([]byte(`{"result":"ok","data":[{"id":"` + groupID + `","type":"scanlation_group","attributes":{"name":"Group"}}]}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL)
+		}
+	}))
+	ctx := context.Background()
+
+	if err = dex.HydrateManga(ctx, []Manga{m}); err != nil {
+		t.Fatal(err)
+	}
+	if authors, ok := m.Authors(); !ok || authors[0].Attributes.Name != "Writer" {
+		t.Errorf("expected hydrated author, got %+v", authors)
+	}
+	if artists, ok := m.Artists(); !ok || artists[0].Attributes.Name != "Writer" {
+		t.Errorf("expected hydrated artist, got %+v", artists)
+	}
+	if related, ok := m.RelatedManga(); !ok || related[0].Manga.Attributes.Year == nil {
+		t.Errorf("expected hydrated related manga, got %+v", related)
+	}
+
+	chapters := []Chapter{{ID: "ch1", Relationships: []Relationship{
+		{ID: groupID, Type: ScanlationGroupRel}, {ID: "u1", Type: UserRel},
+	}}}
+	if err = dex.HydrateChapters(ctx, chapters); err != nil {
+		t.Fatal(err)
+	}
+	if groups, ok := chapters[0].ScanlationGroups(); !ok || groups[0].Attributes.Name != "Group" {
+		t.Errorf("expected hydrated group, got %+v", groups)
+	}
+	if uploader, ok := chapters[0].Uploader(); ok || uploader.ID != "u1" {
+		t.Errorf("expected unexpanded uploader, got %+v", uploader)
+	}
+	if requests != 3 {
+		t.Errorf("expected one request per type, got %d", requests)
+	}
+}
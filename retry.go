@@ -0,0 +1,147 @@
+package mangodex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy : Policy for retrying requests that failed with a transient error.
+//
+// Requests of any method are retried when rate limited, as the API did not process them.
+// Server errors and connection failures are only retried for IdempotentMethods.
+// Requests with a body are only retried if the body can be rewound, such as bodies
+// created from a bytes.Buffer, bytes.Reader or strings.Reader.
+type RetryPolicy struct {
+	// MaxAttempts : Maximum number of attempts, including the first. Values below 2 disable retries.
+	MaxAttempts int
+	// MinBackoff : Backoff before the first retry. Doubled for every following retry.
+	MinBackoff time.Duration
+	// MaxBackoff : Upper bound for the backoff between attempts.
+	MaxBackoff time.Duration
+	// IdempotentMethods : Methods that are safe to retry after server errors and connection failures.
+	IdempotentMethods []string
+}
+
+// DefaultRetryPolicy : Retry up to 3 attempts, backing off from 500ms up to 30s.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+		IdempotentMethods: []string{
+			http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete,
+		},
+	}
+}
+
+// RetryError : Error returned when a request still failed after being attempted more than once.
+type RetryError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("failed after %d attempts: %s", e.Attempts, e.Err.Error())
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// withAttempts : Wrap the final error of a request in a RetryError if it was attempted more than once.
+func withAttempts(err error, attempts int) error {
+	if attempts <= 1 {
+		return err
+	}
+	return &RetryError{Attempts: attempts, Err: err}
+}
+
+// next : Check if a request that failed with err on the given attempt should be retried,
+// and how long to wait before doing so.
+func (p *RetryPolicy) next(method string, attempt int, err error) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxAttempts || !p.retryable(method, err) {
+		return 0, false
+	}
+
+	// Exponential backoff with jitter, in the range [d/2, d].
+	d := p.MinBackoff << uint(attempt-1)
+	if d > p.MaxBackoff || d <= 0 {
+		d = p.MaxBackoff
+	}
+	if half := int64(d / 2); half > 0 {
+		d = time.Duration(half + rand.Int63n(half+1))
+	}
+
+	// Wait at least as long as the API asks us to.
+	if e, ok := asAPIError(err); ok && e.RetryAfter > d {
+		d = e.RetryAfter
+	}
+	return d, true
+}
+
+// retryable : Check if the error is transient for a request with the given method.
+func (p *RetryPolicy) retryable(method string, err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if e, ok := asAPIError(err); ok {
+		switch e.StatusCode {
+		case http.StatusTooManyRequests:
+			return true
+		case http.StatusInternalServerError, http.StatusBadGateway,
+			http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return p.idempotent(method)
+		}
+		return false
+	}
+	return p.idempotent(method) && isConnectionError(err)
+}
+
+// idempotent : Check if the method is one of the IdempotentMethods.
+func (p *RetryPolicy) idempotent(method string) bool {
+	for _, m := range p.IdempotentMethods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// isConnectionError : Check if the error is a transient network failure, such as a reset or timed out connection.
+// Permanent failures, such as unknown hosts, certificate errors or unsupported schemes, are not retried.
+func isConnectionError(err error) bool {
+	var ne net.Error
+	if errors.As(err, &ne) && ne.Timeout() {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// parseRetryAfter : Get how long the server asks us to wait, from either the standard Retry-After
+// header (seconds or HTTP date) or the MangaDex X-RateLimit-Retry-After header (Unix timestamp).
+func parseRetryAfter(header http.Header) time.Duration {
+	if v := header.Get("Retry-After"); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil {
+			return time.Duration(secs) * time.Second
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return time.Until(t)
+		}
+	}
+	if ts, err := strconv.ParseInt(header.Get("X-RateLimit-Retry-After"), 10, 64); err == nil {
+		return time.Until(time.Unix(ts, 0))
+	}
+	return 0
+}
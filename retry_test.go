@@ -0,0 +1,148 @@
+package mangodex
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func testRetryPolicy() *RetryPolicy {
+	p := DefaultRetryPolicy()
+	p.MinBackoff = time.Millisecond
+	p.MaxBackoff = 5 * time.Millisecond
+	return p
+}
+
+func TestRetryTransientErrors(t *testing.T) {
+	var calls int32
+	dex := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		switch r.URL.Path {
+		case "/unavailable":
+			if n < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+		case "/post":
+			// The body must be sent again on every attempt.
+			if b, _ := ioutil.ReadAll(r.Body); string(b) != `{"a":1}` {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			if n < 2 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+		case "/broken":
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte(`{"result":"ok"}`))
+	}))
+	dex.SetRetryPolicy(testRetryPolicy())
+	ctx := context.Background()
+
+	var r Response
+	if err := dex.RequestAndDecode(ctx, http.MethodGet, dex.buildURL("unavailable").String(), nil, &r); err != nil {
+		t.Errorf("expected success after retries, got %v", err)
+	}
+
+	atomic.StoreInt32(&calls, 0)
+	err := dex.RequestAndDecode(ctx, http.MethodPost, dex.buildURL("post").String(), bytes.NewBufferString(`{"a":1}`), &r)
+	if err != nil {
+		t.Errorf("expected rate limited POST to be retried, got %v", err)
+	}
+
+	// Server errors are not retried for POST.
+	atomic.StoreInt32(&calls, 0)
+	_, err = dex.Request(ctx, http.MethodPost, dex.buildURL("broken").String(), nil)
+	if n := atomic.LoadInt32(&calls); n != 1 || err == nil {
+		t.Errorf("expected a single attempt, got %d (%v)", n, err)
+	}
+
+	// The attempt count is exposed on the final error.
+	_, err = dex.Request(ctx, http.MethodGet, dex.buildURL("broken").String(), nil)
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) || retryErr.Attempts != 3 {
+		t.Errorf("expected RetryError with 3 attempts, got %v", err)
+	}
+	if e, ok := asAPIError(err); !ok || e.StatusCode != http.StatusInternalServerError {
+		t.Errorf("expected wrapped APIError, got %v", err)
+	}
+}
+
+func TestRetryStopsOnCancel(t *testing.T) {
+	dex := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	p := testRetryPolicy()
+	p.MinBackoff, p.MaxBackoff = time.Hour, time.Hour
+	dex.SetRetryPolicy(p)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := dex.Request(ctx, http.MethodGet, dex.buildURL("manga").String(), nil)
+	if !errors.Is(err, context.DeadlineExceeded) || time.Since(start) > time.Second {
+		t.Errorf("expected retries to stop on context deadline, got %v", err)
+	}
+}
+
+func TestRetryPermanentNetworkErrors(t *testing.T) {
+	dex := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	dex.SetRetryPolicy(testRetryPolicy())
+
+	_, err := dex.Request(context.Background(), http.MethodGet, "ftp://example.com/manga", nil)
+	var retryErr *RetryError
+	if err == nil || errors.As(err, &retryErr) {
+		t.Errorf("expected a single attempt for an unsupported scheme, got %v", err)
+	}
+
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{&url.Error{Op: "Get", URL: "https://x", Err: &net.DNSError{Err: "no such host", Name: "x", IsNotFound: true}}, false},
+		{&url.Error{Op: "Get", URL: "https://x", Err: errors.New("x509: certificate signed by unknown authority")}, false},
+		{&url.Error{Op: "Get", URL: "https://x", Err: &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}}, true},
+		{&url.Error{Op: "Get", URL: "https://x", Err: &net.DNSError{Err: "timeout", Name: "x", IsTimeout: true}}, true},
+		{&url.Error{Op: "Get", URL: "https://x", Err: io.ErrUnexpectedEOF}, true},
+	}
+	for _, tt := range tests {
+		if got := isConnectionError(tt.err); got != tt.want {
+			t.Errorf("isConnectionError(%v) = %v, expected %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+// TestSetRetryPolicyConcurrently : Run with -race to check the retry policy can be replaced during requests.
+func TestSetRetryPolicyConcurrently(t *testing.T) {
+	dex := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, _ = dex.Request(context.Background(), http.MethodGet, dex.buildURL("manga").String(), nil)
+		}()
+		go func() {
+			defer wg.Done()
+			dex.SetRetryPolicy(testRetryPolicy())
+		}()
+	}
+	wg.Wait()
+}
@@ -1,5 +1,124 @@
 package mangodex
 
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+const (
+	ScanlationGroupListPath            = "group"
+	ScanlationGroupPath                = "group/%s"
+	ToggleScanlationGroupFollowPath    = "group/%s/follow"
+	CheckIfScanlationGroupFollowedPath = "user/follows/group/%s"
+	GetUserFollowedGroupListPath       = "user/follows/group"
+)
+
+// ScanlationGroupService : Provides Scanlation Group services provided by the API.
+type ScanlationGroupService service
+
+// ScanlationGroupResponse : A response for getting a single Scanlation Group.
+type ScanlationGroupResponse struct {
+	Result   string          `json:"result"`
+	Response string          `json:"response"`
+	Data     ScanlationGroup `json:"data"`
+}
+
+func (sr *ScanlationGroupResponse) GetResult() string {
+	return sr.Result
+}
+
+// ScanlationGroupList : A response for getting a list of scanlation groups.
+type ScanlationGroupList struct {
+	Result   string            `json:"result"`
+	Response string            `json:"response"`
+	Data     []ScanlationGroup `json:"data"`
+	Limit    int               `json:"limit"`
+	Offset   int               `json:"offset"`
+	Total    int               `json:"total"`
+}
+
+func (sl *ScanlationGroupList) GetResult() string {
+	return sl.Result
+}
+
+// ScanlationGroup : Struct containing information on a Scanlation Group.
+type ScanlationGroup struct {
+	ID            string                    `json:"id"`
+	Type          string                    `json:"type"`
+	Attributes    ScanlationGroupAttributes `json:"attributes"`
+	Relationships []Relationship            `json:"relationships"`
+}
+
+// GetLeader : Get the leader of the group, or nil if the group has no leader.
+// Returns false if the leader was not expanded with includes[]=leader, in which case only its ID is set.
+func (g *ScanlationGroup) GetLeader() (*User, bool) {
+	for _, rel := range g.Relationships {
+		if rel.Type == LeaderRel {
+			u, ok := relationshipUser(rel)
+			return &u, ok
+		}
+	}
+	return nil, false
+}
+
+// GetMembers : Get the members of the group.
+// Returns false if any member was not expanded with includes[]=member, in which case only its ID is set.
+func (g *ScanlationGroup) GetMembers() ([]User, bool) {
+	var members []User
+	expanded := true
+	for _, rel := range g.Relationships {
+		if rel.Type != MemberRel {
+			continue
+		}
+		u, ok := relationshipUser(rel)
+		if !ok {
+			expanded = false
+		}
+		members = append(members, u)
+	}
+	return members, expanded
+}
+
+// relationshipUser : Get a User from a user, leader or member relationship.
+// Returns false if the relationship was not expanded.
+func relationshipUser(rel Relationship) (User, bool) {
+	u := User{ID: rel.ID, Type: UserRel}
+	attrs, ok := rel.AsUser()
+	if ok {
+		u.Attributes = *attrs
+	}
+	return u, ok
+}
+
+// ScanlationGroupFilter : Filters on the group flags, which the API cannot search by.
+// The filter is applied to groups that were already fetched, so it does not change the Total
+// of the ScanlationGroupList they came from.
+type ScanlationGroupFilter struct {
+	Official *bool
+	Inactive *bool
+}
+
+// Match : Check if a group matches the filter. Nil fields match any group.
+func (f *ScanlationGroupFilter) Match(g *ScanlationGroup) bool {
+	return (f.Official == nil || *f.Official == g.Attributes.Official) &&
+		(f.Inactive == nil || *f.Inactive == g.Attributes.Inactive)
+}
+
+// Apply : Get the groups matching the filter.
+func (f *ScanlationGroupFilter) Apply(groups []ScanlationGroup) []ScanlationGroup {
+	var matched []ScanlationGroup
+	for i := range groups {
+		if f.Match(&groups[i]) {
+			matched = append(matched, groups[i])
+		}
+	}
+	return matched
+}
+
 // ScanlationGroupAttributes : Attributes for a scanlation group
 type ScanlationGroupAttributes struct {
 	Name            string           `json:"name"`
@@ -19,3 +138,214 @@ type ScanlationGroupAttributes struct {
 	CreatedAt       string           `json:"createdAt"`
 	UpdatedAt       string           `json:"updatedAt"`
 }
+
+// GetScanlationGroup : Get a Scanlation Group by ID.
+// https://api.mangadex.org/docs.html#operation/get-group-id
+func (s *ScanlationGroupService) GetScanlationGroup(id string, includes []string) (*ScanlationGroupResponse, error) {
+	return s.GetScanlationGroupContext(context.Background(), id, includes)
+}
+
+// GetScanlationGroupContext : GetScanlationGroup with custom context.
+func (s *ScanlationGroupService) GetScanlationGroupContext(ctx context.Context, id string, includes []string) (*ScanlationGroupResponse, error) {
+	u := s.client.buildURL(fmt.Sprintf(ScanlationGroupPath, id))
+
+	// Set query parameters
+	q := u.Query()
+	for _, i := range includes {
+		q.Add("includes[]", i)
+	}
+	u.RawQuery = q.Encode()
+
+	var r ScanlationGroupResponse
+	err := s.client.RequestAndDecode(ctx, http.MethodGet, u.String(), nil, &r)
+	return &r, err
+}
+
+// SearchScanlationGroups : Search for Scanlation Groups.
+// https://api.mangadex.org/docs.html#operation/get-search-group
+func (s *ScanlationGroupService) SearchScanlationGroups(q *ScanlationGroupSearchQuery) (*ScanlationGroupList, error) {
+	return s.SearchScanlationGroupsContext(context.Background(), q)
+}
+
+// SearchScanlationGroupsContext : SearchScanlationGroups with custom context.
+func (s *ScanlationGroupService) SearchScanlationGroupsContext(ctx context.Context, q *ScanlationGroupSearchQuery) (*ScanlationGroupList, error) {
+	params, err := q.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	u := s.client.buildURL(ScanlationGroupListPath)
+	u.RawQuery = params.Encode()
+
+	var l ScanlationGroupList
+	err = s.client.RequestAndDecode(ctx, http.MethodGet, u.String(), nil, &l)
+	return &l, err
+}
+
+// ScanlationGroupEdit : Fields for creating or updating a Scanlation Group. Nil fields are not sent.
+type ScanlationGroupEdit struct {
+	Name             string   `json:"name"`
+	Website          *string  `json:"website,omitempty"`
+	IRCServer        *string  `json:"ircServer,omitempty"`
+	Discord          *string  `json:"discord,omitempty"`
+	ContactEmail     *string  `json:"contactEmail,omitempty"`
+	Description      *string  `json:"description,omitempty"`
+	Twitter          *string  `json:"twitter,omitempty"`
+	FocusedLanguages []string `json:"focusedLanguages,omitempty"`
+	Inactive         *bool    `json:"inactive,omitempty"`
+	PublishDelay     *string  `json:"publishDelay,omitempty"`
+	// Version : Current version of the group, required for updates.
+	// The update fails if the group was modified since this version.
+	Version int `json:"version,omitempty"`
+}
+
+// CreateScanlationGroup : Create a Scanlation Group.
+// https://api.mangadex.org/docs.html#operation/post-group
+func (s *ScanlationGroupService) CreateScanlationGroup(edit ScanlationGroupEdit) (*ScanlationGroupResponse, error) {
+	return s.CreateScanlationGroupContext(context.Background(), edit)
+}
+
+// CreateScanlationGroupContext : CreateScanlationGroup with custom context.
+func (s *ScanlationGroupService) CreateScanlationGroupContext(ctx context.Context, edit ScanlationGroupEdit) (*ScanlationGroupResponse, error) {
+	u := s.client.buildURL(ScanlationGroupListPath)
+
+	// A new group has no version.
+	edit.Version = 0
+	rBytes, err := json.Marshal(&edit)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ScanlationGroupResponse
+	err = s.client.RequestAndDecode(ctx, http.MethodPost, u.String(), bytes.NewBuffer(rBytes), &r)
+	return &r, err
+}
+
+// UpdateScanlationGroup : Update a Scanlation Group. The edit must contain the current version of the group.
+// https://api.mangadex.org/docs.html#operation/put-group-id
+func (s *ScanlationGroupService) UpdateScanlationGroup(id string, edit ScanlationGroupEdit) (*ScanlationGroupResponse, error) {
+	return s.UpdateScanlationGroupContext(context.Background(), id, edit)
+}
+
+// UpdateScanlationGroupContext : UpdateScanlationGroup with custom context.
+func (s *ScanlationGroupService) UpdateScanlationGroupContext(ctx context.Context, id string, edit ScanlationGroupEdit) (*ScanlationGroupResponse, error) {
+	if edit.Version < 1 {
+		return nil, fmt.Errorf("updating scanlation group %s requires its current version", id)
+	}
+	u := s.client.buildURL(fmt.Sprintf(ScanlationGroupPath, id))
+
+	rBytes, err := json.Marshal(&edit)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ScanlationGroupResponse
+	err = s.client.RequestAndDecode(ctx, http.MethodPut, u.String(), bytes.NewBuffer(rBytes), &r)
+	return &r, err
+}
+
+// DeleteScanlationGroup : Delete a Scanlation Group.
+// https://api.mangadex.org/docs.html#operation/delete-group-id
+func (s *ScanlationGroupService) DeleteScanlationGroup(id string) (*Response, error) {
+	return s.DeleteScanlationGroupContext(context.Background(), id)
+}
+
+// DeleteScanlationGroupContext : DeleteScanlationGroup with custom context.
+func (s *ScanlationGroupService) DeleteScanlationGroupContext(ctx context.Context, id string) (*Response, error) {
+	u := s.client.buildURL(fmt.Sprintf(ScanlationGroupPath, id))
+
+	var r Response
+	err := s.client.RequestAndDecode(ctx, http.MethodDelete, u.String(), nil, &r)
+	return &r, err
+}
+
+// CheckIfScanlationGroupFollowed : Check if a user follows a Scanlation Group.
+func (s *ScanlationGroupService) CheckIfScanlationGroupFollowed(id string) (bool, error) {
+	return s.CheckIfScanlationGroupFollowedContext(context.Background(), id)
+}
+
+// CheckIfScanlationGroupFollowedContext : CheckIfScanlationGroupFollowed with custom context.
+func (s *ScanlationGroupService) CheckIfScanlationGroupFollowedContext(ctx context.Context, id string) (bool, error) {
+	u := s.client.buildURL(fmt.Sprintf(CheckIfScanlationGroupFollowedPath, id))
+
+	var r Response
+	err := s.client.RequestAndDecode(ctx, http.MethodGet, u.String(), nil, &r)
+	if err != nil {
+		if IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// ToggleScanlationGroupFollowStatus : Toggle follow status for a Scanlation Group.
+func (s *ScanlationGroupService) ToggleScanlationGroupFollowStatus(id string, toFollow bool) (*Response, error) {
+	return s.ToggleScanlationGroupFollowStatusContext(context.Background(), id, toFollow)
+}
+
+// ToggleScanlationGroupFollowStatusContext : ToggleScanlationGroupFollowStatus with custom context.
+func (s *ScanlationGroupService) ToggleScanlationGroupFollowStatusContext(ctx context.Context, id string, toFollow bool) (*Response, error) {
+	u := s.client.buildURL(fmt.Sprintf(ToggleScanlationGroupFollowPath, id))
+
+	method := http.MethodPost // To follow
+	if !toFollow {
+		method = http.MethodDelete // To unfollow
+	}
+
+	var r Response
+	err := s.client.RequestAndDecode(ctx, method, u.String(), nil, &r)
+	return &r, err
+}
+
+// GetUserFollowedScanlationGroupList : Return list of followed Scanlation Groups.
+// https://api.mangadex.org/docs.html#operation/get-user-follows-group
+func (s *ScanlationGroupService) GetUserFollowedScanlationGroupList(limit, offset int, includes []string) (*ScanlationGroupList, error) {
+	return s.GetUserFollowedScanlationGroupListContext(context.Background(), limit, offset, includes)
+}
+
+// GetUserFollowedScanlationGroupListContext : GetUserFollowedScanlationGroupList with custom context.
+func (s *ScanlationGroupService) GetUserFollowedScanlationGroupListContext(ctx context.Context, limit, offset int, includes []string) (*ScanlationGroupList, error) {
+	u := s.client.buildURL(GetUserFollowedGroupListPath)
+
+	// Set required query parameters
+	q := u.Query()
+	q.Add("limit", strconv.Itoa(limit))
+	q.Add("offset", strconv.Itoa(offset))
+	for _, i := range includes {
+		q.Add("includes[]", i)
+	}
+	u.RawQuery = q.Encode()
+
+	var l ScanlationGroupList
+	err := s.client.RequestAndDecode(ctx, http.MethodGet, u.String(), nil, &l)
+	return &l, err
+}
+
+// GetScanlationGroupChapters : Get chapters uploaded by a Scanlation Group, filtered by a feed query.
+// https://api.mangadex.org/docs.html#operation/get-chapter
+func (s *ScanlationGroupService) GetScanlationGroupChapters(id string, q *ChapterFeedQuery) (*ChapterList, error) {
+	return s.GetScanlationGroupChaptersContext(context.Background(), id, q)
+}
+
+// GetScanlationGroupChaptersContext : GetScanlationGroupChapters with custom context.
+func (s *ScanlationGroupService) GetScanlationGroupChaptersContext(ctx context.Context, id string, q *ChapterFeedQuery) (*ChapterList, error) {
+	// Filter on this group, without modifying the caller's query.
+	gq := ChapterFeedQuery{}
+	if q != nil {
+		gq = *q
+	}
+	gq.Groups = []string{id}
+
+	params, err := gq.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	u := s.client.buildURL(ChapterListPath)
+	u.RawQuery = params.Encode()
+
+	var l ChapterList
+	err = s.client.RequestAndDecode(ctx, http.MethodGet, u.String(), nil, &l)
+	return &l, err
+}
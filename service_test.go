@@ -0,0 +1,401 @@
+package mangodex
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// entityHandler : Serve a single entity of the requested type, echoing the requested includes.
+func entityHandler(t *testing.T, path, typ string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/"+path {
+			t.Errorf("unexpected path %s, expected /%s", r.URL.Path, path)
+		}
+		if inc := r.URL.Query()["includes[]"]; len(inc) != 1 || inc[0] != AuthorRel {
+			t.Errorf("unexpected includes %v", inc)
+		}
+		fmt.Fprintf(w, `{"result":"ok","response":"entity","data":{"id":%q,"type":%q,"attributes":{}}}`, testUUID, typ)
+	}
+}
+
+func TestGetSingleEntities(t *testing.T) {
+	includes := []string{AuthorRel}
+
+	dex := newTestClient(t, entityHandler(t, fmt.Sprintf(MangaPath, testUUID), MangaRel))
+	if m, err := dex.Manga.GetManga(testUUID, includes); err != nil || m.Data.ID != testUUID {
+		t.Errorf("GetManga failed: %v", err)
+	}
+
+	dex = newTestClient(t, entityHandler(t, fmt.Sprintf(ChapterPath, testUUID), ChapterRel))
+	if c, err := dex.Chapter.GetChapter(testUUID, includes); err != nil || c.Data.Type != ChapterRel {
+		t.Errorf("GetChapter failed: %v", err)
+	}
+
+	dex = newTestClient(t, entityHandler(t, fmt.Sprintf(AuthorPath, testUUID), AuthorRel))
+	if a, err := dex.Author.GetAuthor(testUUID, includes); err != nil || a.Data.Type != AuthorRel {
+		t.Errorf("GetAuthor failed: %v", err)
+	}
+
+	dex = newTestClient(t, entityHandler(t, fmt.Sprintf(ScanlationGroupPath, testUUID), ScanlationGroupRel))
+	if g, err := dex.ScanlationGroup.GetScanlationGroup(testUUID, includes); err != nil || g.Data.Type != ScanlationGroupRel {
+		t.Errorf("GetScanlationGroup failed: %v", err)
+	}
+}
+
+func TestGetAuthorWorks(t *testing.T) {
+	dex := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if ratings := strings.Join(q["contentRating[]"], ","); ratings != "safe,suggestive,erotica,pornographic" {
+			t.Errorf("expected every content rating, got %q", ratings)
+		}
+		switch {
+		case q.Get("authors[]") == testUUID:
+			_, _ = w.Write([]byte(`{"result":"ok","data":[{"id":"a"},{"id":"b"}],"total":2}`))
+		case q.Get("artists[]") == testUUID:
+			_, _ = w.Write([]byte(`{"result":"ok","data":[{"id":"b"},{"id":"c"}],"total":2}`))
+		default:
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+	}))
+
+	works, err := dex.Author.GetAuthorWorks(testUUID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(works) != 3 || works[0].ID != "a" || works[2].ID != "c" {
+		t.Errorf("expected works a, b, c, got %v", works)
+	}
+
+	if _, err = dex.Author.UpdateAuthor(testUUID, AuthorEdit{Name: "No version"}); err == nil {
+		t.Error("expected error when updating without a version")
+	}
+}
+
+func TestSearchScanlationGroups(t *testing.T) {
+	dex := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/" + ScanlationGroupListPath:
+			if r.URL.Query().Get("focusedLanguage") != "en" {
+				t.Errorf("missing focusedLanguage filter: %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{"result":"ok","data":[
+				{"id":"g1","type":"scanlation_group","attributes":{"name":"One","official":true},
+				 "relationships":[{"id":"u1","type":"leader","attributes":{"username":"lead"}},{"id":"u2","type":"member"}]},
+				{"id":"g2","type":"scanlation_group","attributes":{"name":"Two","official":false}}
+			],"total":2}`))
+		case "/" + ChapterListPath:
+			if r.URL.Query().Get("groups[]") != testUUID {
+				t.Errorf("missing groups filter: %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{"result":"ok","data":[],"total":0}`))
+		}
+	}))
+
+	l, err := dex.ScanlationGroup.SearchScanlationGroups(&ScanlationGroupSearchQuery{
+		FocusedLanguage: "en",
+		Includes:        []string{LeaderRel},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(l.Data) != 2 || l.Total != 2 {
+		t.Fatalf("expected the page to match Total, got %d of %d", len(l.Data), l.Total)
+	}
+
+	official := true
+	groups := (&ScanlationGroupFilter{Official: &official}).Apply(l.Data)
+	if len(groups) != 1 || groups[0].ID != "g1" {
+		t.Fatalf("expected only the official group, got %+v", groups)
+	}
+	if leader, ok := groups[0].GetLeader(); !ok || leader.Attributes.Username != "lead" {
+		t.Errorf("unexpected leader %+v", leader)
+	}
+	if members, ok := groups[0].GetMembers(); ok || len(members) != 1 || members[0].ID != "u2" {
+		t.Errorf("expected unexpanded member u2, got %+v", members)
+	}
+
+	if _, err = dex.ScanlationGroup.GetScanlationGroupChapters(testUUID, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCustomList(t *testing.T) {
+	var calls []string
+	dex := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch r.URL.Path {
+		case "/list/l1":
+			_, _ = w.Write([]byte(`{"result":"ok","data":{"id":"l1","type":"custom_list",
+				"attributes":{"name":"Faves","visibility":"private","version":2},
+				"relationships":[{"id":"m1","type":"manga"},{"id":"u1","type":"user"}]}}`))
+		case "/list/l1/feed":
+			if r.URL.Query().Get("translatedLanguage[]") != "en" {
+				t.Errorf("missing language filter: %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{"result":"ok","data":[],"total":0}`))
+		case "/user/list":
+			if r.URL.Query().Get("limit") != "10" {
+				t.Errorf("missing limit: %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{"result":"ok","data":[{"id":"l1","type":"custom_list"}],"total":1}`))
+		default:
+			_, _ = w.Write([]byte(`{"result":"ok"}`))
+		}
+	}))
+
+	l, err := dex.CustomList.GetCustomList("l1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := l.Data.GetMangaIDs(); len(ids) != 1 || ids[0] != "m1" || l.Data.Attributes.Version != 2 {
+		t.Errorf("unexpected list %+v", l.Data)
+	}
+
+	if _, err = dex.CustomList.UpdateCustomList("l1", CustomListEdit{Name: "Faves"}); err == nil {
+		t.Error("expected update without version to fail")
+	}
+	if _, err = dex.CustomList.GetCustomListFeed("l1", &ChapterFeedQuery{TranslatedLanguage: []string{"en"}}); err != nil {
+		t.Error(err)
+	}
+	if _, err = dex.CustomList.GetCustomListFeed("l2", nil); err != nil {
+		t.Error(err)
+	}
+	if lists, err := dex.CustomList.GetLoggedUserCustomLists(10, 0); err != nil || len(lists.Data) != 1 {
+		t.Errorf("unexpected lists %+v: %v", lists, err)
+	}
+	if _, err = dex.CustomList.AddMangaToCustomList("m2", "l1"); err != nil {
+		t.Error(err)
+	}
+	if _, err = dex.CustomList.ToggleCustomListFollowStatus("l1", false); err != nil {
+		t.Error(err)
+	}
+
+	want := []string{"GET /list/l1", "GET /list/l1/feed", "GET /list/l2/feed", "GET /user/list", "POST /manga/m2/list/l1", "DELETE /list/l1/follow"}
+	if strings.Join(calls, ",") != strings.Join(want, ",") {
+		t.Errorf("unexpected calls %v", calls)
+	}
+}
+
+func TestReadingStatus(t *testing.T) {
+	var bodies []string
+	dex := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/manga/status":
+			if r.URL.Query().Get("status") == string(Dropped) {
+				// The API sends an empty map as an empty array.
+				_, _ = w.Write([]byte(`{"result":"ok","statuses":[]}`))
+				return
+			}
+			if r.URL.Query().Get("status") != string(Reading) {
+				t.Errorf("missing status filter: %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{"result":"ok","statuses":{"m1":"reading","m2":"reading"}}`))
+		case r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`{"result":"ok","status":null}`))
+		default:
+			b, _ := ioutil.ReadAll(r.Body)
+			bodies = append(bodies, string(b))
+			_, _ = w.Write([]byte(`{"result":"ok"}`))
+		}
+	}))
+
+	if status, err := dex.Manga.GetReadingStatus("m1"); err != nil || status != "" {
+		t.Errorf("expected no status, got %q: %v", status, err)
+	}
+	if _, err := dex.Manga.SetReadingStatus("m1", OnHold); err != nil {
+		t.Error(err)
+	}
+	if _, err := dex.Manga.SetReadingStatus("m1", ""); err != nil {
+		t.Error(err)
+	}
+	if _, err := dex.Manga.SetReadingStatus("m1", "paused"); err == nil {
+		t.Error("expected invalid status to fail")
+	}
+	if strings.Join(bodies, ",") != `{"status":"on_hold"},{"status":null}` {
+		t.Errorf("unexpected bodies %v", bodies)
+	}
+
+	statuses, err := dex.Manga.GetAllReadingStatuses(Reading)
+	if err != nil || len(statuses) != 2 || statuses["m2"] != Reading {
+		t.Errorf("unexpected statuses %v: %v", statuses, err)
+	}
+	statuses, err = dex.Manga.GetAllReadingStatuses(Dropped)
+	if err != nil || len(statuses) != 0 {
+		t.Errorf("unexpected statuses %v: %v", statuses, err)
+	}
+}
+
+func TestStatisticsAndRatings(t *testing.T) {
+	var batches []int
+	var rated string
+	dex := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/"+MangaStatisticsListPath:
+			ids := r.URL.Query()["manga[]"]
+			batches = append(batches, len(ids))
+			stats := map[string]MangaStatistics{}
+			for _, id := range ids {
+				stats[id] = MangaStatistics{Follows: 1}
+			}
+			_ = json.NewEncoder(w).Encode(&MangaStatisticsResponse{Result: "ok", Statistics: stats})
+		case strings.HasPrefix(r.URL.Path, "/statistics/manga/"):
+			_, _ = w.Write([]byte(`{"result":"ok","statistics":{"` + testUUID + `":{
+				"rating":{"average":null,"bayesian":7.25,"distribution":{"1":0,"10":3}},"follows":42}}}`))
+		case r.URL.Path == "/"+RatingListPath:
+			_, _ = w.Write([]byte(`{"result":"ok","ratings":{"` + testUUID + `":{"rating":9,"createdAt":"2021-01-01T00:00:00+00:00"}}}`))
+		default:
+			b, _ := ioutil.ReadAll(r.Body)
+			rated = r.Method + " " + r.URL.Path + " " + string(b)
+			_, _ = w.Write([]byte(`{"result":"ok"}`))
+		}
+	}))
+
+	st, err := dex.Statistics.GetMangaStatistics(testUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.Follows != 42 || st.Rating.Average != 0 || st.Rating.Bayesian != 7.25 || st.Rating.Distribution["10"] != 3 {
+		t.Errorf("unexpected statistics %+v", st)
+	}
+
+	var ids []string
+	for i := 0; i < 250; i++ {
+		ids = append(ids, fmt.Sprintf("00000000-0000-0000-0000-%012d", i))
+	}
+	all, err := dex.Statistics.GetMangaStatisticsList(ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 250 || fmt.Sprint(batches) != "[100 100 50]" {
+		t.Errorf("got %d statistics in batches %v", len(all), batches)
+	}
+
+	ratings, err := dex.Rating.GetRatings([]string{testUUID})
+	if err != nil || ratings[testUUID].Rating != 9 {
+		t.Errorf("unexpected ratings %v: %v", ratings, err)
+	}
+	if _, err = dex.Rating.SetRating(testUUID, 11); err == nil {
+		t.Error("expected out of range rating to fail")
+	}
+	if _, err = dex.Rating.SetRating(testUUID, 8); err != nil {
+		t.Error(err)
+	}
+	if rated != "POST /rating/"+testUUID+` {"rating":8}` {
+		t.Errorf("unexpected rating request %q", rated)
+	}
+}
+
+func TestEmptyStatisticsAndRatings(t *testing.T) {
+	// The API sends empty maps as empty arrays.
+	dex := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/"+RatingListPath {
+			_, _ = w.Write([]byte(`{"result":"ok","ratings":[]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"result":"ok","statistics":[]}`))
+	}))
+
+	ratings, err := dex.Rating.GetRatings([]string{testUUID})
+	if err != nil || len(ratings) != 0 {
+		t.Errorf("unexpected ratings %v: %v", ratings, err)
+	}
+	all, err := dex.Statistics.GetMangaStatisticsList([]string{testUUID})
+	if err != nil || len(all) != 0 {
+		t.Errorf("unexpected statistics %v: %v", all, err)
+	}
+	if _, err = dex.Statistics.GetMangaStatistics(testUUID); err == nil || !strings.Contains(err.Error(), "no statistics returned") {
+		t.Errorf("expected missing statistics error, got %v", err)
+	}
+}
+
+func TestLegacyCache(t *testing.T) {
+	var requested [][]int
+	dex := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Type string `json:"type"`
+			IDs  []int  `json:"ids"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Type != LegacyMangaType {
+			t.Errorf("unexpected body %+v: %v", body, err)
+		}
+		requested = append(requested, body.IDs)
+
+		l := LegacyMappingList{Result: "ok"}
+		for _, id := range body.IDs {
+			if id != 404 {
+				l.Data = append(l.Data, LegacyMapping{Attributes: LegacyMappingAttributes{
+					Type: body.Type, LegacyID: id, NewID: fmt.Sprintf("uuid-%d", id)}})
+			}
+		}
+		_ = json.NewEncoder(w).Encode(&l)
+	}))
+	ctx := context.Background()
+	cache := NewLegacyCache(dex)
+
+	uuids, err := cache.Resolve(ctx, LegacyMangaType, []int{1, 2, 404})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(uuids) != 2 || uuids[2] != "uuid-2" {
+		t.Errorf("unexpected mapping %v", uuids)
+	}
+	if _, err = cache.Resolve(ctx, LegacyMangaType, []int{2, 3, 404}); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(requested) != "[[1 2 404] [3]]" {
+		t.Errorf("unexpected requests %v", requested)
+	}
+	if uuid, ok := cache.Get(LegacyMangaType, 3); !ok || uuid != "uuid-3" {
+		t.Errorf("expected cached mapping, got %q", uuid)
+	}
+	if _, ok := cache.Get(LegacyMangaType, 404); ok {
+		t.Error("expected no mapping for an unknown ID")
+	}
+	cache.Clear()
+	if _, err = cache.Resolve(ctx, LegacyMangaType, []int{404}); err != nil || len(requested) != 3 {
+		t.Errorf("expected unknown ID to be requested again after Clear, got %v: %v", requested, err)
+	}
+	if _, err = dex.Legacy.GetUUIDs("author", []int{1}); err == nil {
+		t.Error("expected invalid type to fail")
+	}
+}
+
+func TestNilQueries(t *testing.T) {
+	dex := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"result":"ok","data":[],"total":0}`))
+	}))
+
+	if _, err := dex.Manga.SearchManga(nil); err != nil {
+		t.Error(err)
+	}
+	if _, err := dex.Author.SearchAuthors(nil); err != nil {
+		t.Error(err)
+	}
+	if _, err := dex.Cover.SearchCovers(nil); err != nil {
+		t.Error(err)
+	}
+	if _, err := dex.ScanlationGroup.SearchScanlationGroups(nil); err != nil {
+		t.Error(err)
+	}
+	if _, err := dex.Chapter.GetMangaFeed(testUUID, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFollowedMangaFeed(t *testing.T) {
+	dex := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/"+GetUserFollowedMangaFeedPath || r.URL.RawQuery != "" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		_, _ = w.Write([]byte(`{"result":"ok","data":[],"total":0}`))
+	}))
+
+	if _, err := dex.User.GetFollowedMangaFeed(nil); err != nil {
+		t.Error(err)
+	}
+}
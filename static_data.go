@@ -6,6 +6,7 @@ const (
 	ShoujoDemographic string = "shoujo"
 	JoseiDemographic  string = "josei"
 	SeinenDemograpic  string = "seinen"
+	NoneDemographic   string = "none"
 )
 
 // Manga publication status
@@ -16,16 +17,28 @@ const (
 	CancelledStatus string = "cancelled"
 )
 
+// ReadingStatus : A user's reading status for a Manga.
+type ReadingStatus string
+
 // Manga reading status
 const (
-	Reading    string = "reading"
-	OnHold     string = "on_hold"
-	PlanToRead string = "plan_to_read"
-	Dropped    string = "dropped"
-	ReReading  string = "re_reading"
-	Completed  string = "completed"
+	Reading    ReadingStatus = "reading"
+	OnHold     ReadingStatus = "on_hold"
+	PlanToRead ReadingStatus = "plan_to_read"
+	Dropped    ReadingStatus = "dropped"
+	ReReading  ReadingStatus = "re_reading"
+	Completed  ReadingStatus = "completed"
 )
 
+// Valid : Check if the reading status is one accepted by the API.
+func (s ReadingStatus) Valid() bool {
+	switch s {
+	case Reading, OnHold, PlanToRead, Dropped, ReReading, Completed:
+		return true
+	}
+	return false
+}
+
 // Manga content rating
 const (
 	Safe       string = "safe"
@@ -34,6 +47,12 @@ const (
 	Porn       string = "pornographic"
 )
 
+// Custom list visibility
+const (
+	PublicVisibility  string = "public"
+	PrivateVisibility string = "private"
+)
+
 // Relationship types. Useful for reference expansions
 const (
 	MangaRel           string = "manga"
@@ -45,4 +64,50 @@ const (
 	TagRel             string = "tag"
 	UserRel            string = "user"
 	CustomListRel      string = "custom_list"
+	LeaderRel          string = "leader"
+	MemberRel          string = "member"
+)
+
+// Tag groups
+const (
+	GenreTagGroup   string = "genre"
+	ThemeTagGroup   string = "theme"
+	FormatTagGroup  string = "format"
+	ContentTagGroup string = "content"
+)
+
+// Tag inclusion and exclusion modes
+const (
+	TagsModeAnd string = "AND"
+	TagsModeOr  string = "OR"
+)
+
+// Order directions
+const (
+	OrderAsc  string = "asc"
+	OrderDesc string = "desc"
+)
+
+// Manga search order fields
+const (
+	OrderByTitle                 string = "title"
+	OrderByYear                  string = "year"
+	OrderByCreatedAt             string = "createdAt"
+	OrderByUpdatedAt             string = "updatedAt"
+	OrderByLatestUploadedChapter string = "latestUploadedChapter"
+	OrderByFollowedCount         string = "followedCount"
+	OrderByRelevance             string = "relevance"
+)
+
+// Author and scanlation group order fields
+const (
+	OrderByName string = "name"
+)
+
+// Chapter feed order fields, in addition to OrderByCreatedAt and OrderByUpdatedAt
+const (
+	OrderByPublishAt  string = "publishAt"
+	OrderByReadableAt string = "readableAt"
+	OrderByVolume     string = "volume"
+	OrderByChapter    string = "chapter"
 )
@@ -0,0 +1,123 @@
+package mangodex
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	MangaStatisticsPath     = "statistics/manga/%s"
+	MangaStatisticsListPath = "statistics/manga"
+
+	// maxIDsPerRequest : Maximum number of IDs sent in a single batch request.
+	maxIDsPerRequest = 100
+)
+
+// StatisticsService : Provides statistics services provided by the API.
+type StatisticsService service
+
+// MangaStatisticsResponse : A response for getting statistics of one or more Manga.
+type MangaStatisticsResponse struct {
+	Result string `json:"result"`
+	// Statistics : Map of Manga ID to its statistics.
+	Statistics map[string]MangaStatistics `json:"statistics"`
+}
+
+func (r *MangaStatisticsResponse) GetResult() string {
+	return r.Result
+}
+
+func (r *MangaStatisticsResponse) UnmarshalJSON(data []byte) error {
+	raw := struct {
+		Result     string          `json:"result"`
+		Statistics json.RawMessage `json:"statistics"`
+	}{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	r.Result = raw.Result
+	r.Statistics = map[string]MangaStatistics{}
+	return unmarshalMap(raw.Statistics, &r.Statistics)
+}
+
+// MangaStatistics : Rating and follow statistics of a Manga.
+type MangaStatistics struct {
+	Rating  RatingStatistics `json:"rating"`
+	Follows int              `json:"follows"`
+}
+
+// RatingStatistics : Rating statistics of a Manga. Average and Bayesian are 0 if the Manga has no ratings.
+type RatingStatistics struct {
+	Average  float64 `json:"average"`
+	Bayesian float64 `json:"bayesian"`
+	// Distribution : Map of rating, "1" to "10", to number of ratings.
+	Distribution map[string]int `json:"distribution"`
+}
+
+// GetMangaStatistics : Get the statistics of a Manga.
+// https://api.mangadex.org/docs.html#operation/get-statistics-manga-uuid
+func (s *StatisticsService) GetMangaStatistics(id string) (*MangaStatistics, error) {
+	return s.GetMangaStatisticsContext(context.Background(), id)
+}
+
+// GetMangaStatisticsContext : GetMangaStatistics with custom context.
+func (s *StatisticsService) GetMangaStatisticsContext(ctx context.Context, id string) (*MangaStatistics, error) {
+	u := s.client.buildURL(fmt.Sprintf(MangaStatisticsPath, id))
+
+	var r MangaStatisticsResponse
+	if err := s.client.RequestAndDecode(ctx, http.MethodGet, u.String(), nil, &r); err != nil {
+		return nil, err
+	}
+	stats, ok := r.Statistics[id]
+	if !ok {
+		return nil, fmt.Errorf("no statistics returned for manga %s", id)
+	}
+	return &stats, nil
+}
+
+// GetMangaStatisticsList : Get the statistics of many Manga, as a map of Manga ID to statistics.
+// Large sets of IDs are split over several requests.
+// https://api.mangadex.org/docs.html#operation/get-statistics-manga
+func (s *StatisticsService) GetMangaStatisticsList(ids []string) (map[string]MangaStatistics, error) {
+	return s.GetMangaStatisticsListContext(context.Background(), ids)
+}
+
+// GetMangaStatisticsListContext : GetMangaStatisticsList with custom context.
+func (s *StatisticsService) GetMangaStatisticsListContext(ctx context.Context, ids []string) (map[string]MangaStatistics, error) {
+	if err := checkIDs("manga", ids); err != nil {
+		return nil, fmt.Errorf("invalid statistics query: %s", err.Error())
+	}
+
+	stats := map[string]MangaStatistics{}
+	for _, chunk := range chunkIDs(ids, maxIDsPerRequest) {
+		u := s.client.buildURL(MangaStatisticsListPath)
+		q := u.Query()
+		addAll(q, "manga[]", chunk)
+		u.RawQuery = q.Encode()
+
+		var r MangaStatisticsResponse
+		if err := s.client.RequestAndDecode(ctx, http.MethodGet, u.String(), nil, &r); err != nil {
+			return nil, err
+		}
+		for id, st := range r.Statistics {
+			stats[id] = st
+		}
+	}
+	return stats, nil
+}
+
+// chunkIDs : Split IDs into chunks of at most n IDs.
+func chunkIDs(ids []string, n int) [][]string {
+	var chunks [][]string
+	for len(ids) > n {
+		chunks = append(chunks, ids[:n])
+		ids = ids[n:]
+	}
+	if len(ids) > 0 {
+		chunks = append(chunks, ids)
+	}
+	return chunks
+}
@@ -0,0 +1,168 @@
+package mangodex
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// TagList : A response for getting the tag catalogue.
+type TagList struct {
+	Result   string `json:"result"`
+	Response string `json:"response"`
+	Data     []Tag  `json:"data"`
+	Limit    int    `json:"limit"`
+	Offset   int    `json:"offset"`
+	Total    int    `json:"total"`
+}
+
+func (tl *TagList) GetResult() string {
+	return tl.Result
+}
+
+// TagNameError : Returned when tag names cannot be resolved to a single tag.
+type TagNameError struct {
+	// Unknown : Names that match no tag.
+	Unknown []string
+	// Ambiguous : Names that match more than one tag, with the IDs of the matching tags.
+	Ambiguous map[string][]string
+}
+
+func (e *TagNameError) Error() string {
+	var parts []string
+	if len(e.Unknown) > 0 {
+		parts = append(parts, fmt.Sprintf("unknown tags %q", e.Unknown))
+	}
+	if len(e.Ambiguous) > 0 {
+		names := make([]string, 0, len(e.Ambiguous))
+		for name := range e.Ambiguous {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		parts = append(parts, fmt.Sprintf("ambiguous tags %q", names))
+	}
+	return "cannot resolve tag names: " + strings.Join(parts, ", ")
+}
+
+// TagResolver : Resolves tag names to IDs using a cached copy of the tag catalogue.
+// The catalogue is fetched on first use. A TagResolver is safe for concurrent use.
+type TagResolver struct {
+	service *MangaService
+
+	mu     sync.Mutex
+	tags   []Tag
+	byName map[string][]string
+}
+
+// NewTagResolver : Create a TagResolver fetching the catalogue with the given client.
+func NewTagResolver(c *DexClient) *TagResolver {
+	return &TagResolver{service: c.Manga}
+}
+
+// catalogue : Get the cached catalogue, fetching it if required.
+func (r *TagResolver) catalogue(ctx context.Context) ([]Tag, map[string][]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.byName == nil {
+		l, err := r.service.GetTagsContext(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		r.load(l.Data)
+	}
+	return r.tags, r.byName, nil
+}
+
+// load : Index tags by their lowercased name in every language.
+func (r *TagResolver) load(tags []Tag) {
+	r.tags = tags
+	r.byName = map[string][]string{}
+	for _, t := range tags {
+		for _, name := range t.Attributes.Name.Values {
+			key := strings.ToLower(strings.TrimSpace(name))
+			if key != "" && !containsString(r.byName[key], t.ID) {
+				r.byName[key] = append(r.byName[key], t.ID)
+			}
+		}
+	}
+}
+
+// Refresh : Fetch the catalogue again, replacing the cached copy.
+func (r *TagResolver) Refresh(ctx context.Context) error {
+	l, err := r.service.GetTagsContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.load(l.Data)
+	return nil
+}
+
+// Tags : Get all tags in the catalogue.
+func (r *TagResolver) Tags(ctx context.Context) ([]Tag, error) {
+	tags, _, err := r.catalogue(ctx)
+	return append([]Tag(nil), tags...), err
+}
+
+// TagsByGroup : Get the tags in the catalogue grouped by their group, such as GenreTagGroup.
+func (r *TagResolver) TagsByGroup(ctx context.Context) (map[string][]Tag, error) {
+	tags, _, err := r.catalogue(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := map[string][]Tag{}
+	for _, t := range tags {
+		groups[t.Attributes.Group] = append(groups[t.Attributes.Group], t)
+	}
+	return groups, nil
+}
+
+// Resolve : Get the IDs of the tags with the given names, in order.
+// Names match case-insensitively in any language. If any name is unknown or ambiguous,
+// a *TagNameError listing all such names is returned.
+func (r *TagResolver) Resolve(ctx context.Context, names ...string) ([]string, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	_, byName, err := r.catalogue(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	nameErr := &TagNameError{}
+	for _, name := range names {
+		matches := byName[strings.ToLower(strings.TrimSpace(name))]
+		switch len(matches) {
+		case 0:
+			nameErr.Unknown = append(nameErr.Unknown, name)
+		case 1:
+			ids = append(ids, matches[0])
+		default:
+			if nameErr.Ambiguous == nil {
+				nameErr.Ambiguous = map[string][]string{}
+			}
+			nameErr.Ambiguous[name] = append([]string(nil), matches...)
+		}
+	}
+	if len(nameErr.Unknown) > 0 || len(nameErr.Ambiguous) > 0 {
+		return nil, nameErr
+	}
+	return ids, nil
+}
+
+// containsString : Check if a slice contains a string.
+func containsString(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+package mangodex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+const tagCatalogue = `{"result":"ok","data":[
+	{"id":"11111111-1111-1111-1111-111111111111","type":"tag","attributes":{"name":{"en":"Action","ja":"アクション"},"group":"genre"}},
+	{"id":"22222222-2222-2222-2222-222222222222","type":"tag","attributes":{"name":{"en":"Romance"},"group":"genre"}},
+	{"id":"33333333-3333-3333-3333-333333333333","type":"tag","attributes":{"name":{"en":"Long Strip"},"group":"format"}},
+	{"id":"44444444-4444-4444-4444-444444444444","type":"tag","attributes":{"name":{"en":"Gore","fr":"Romance"},"group":"content"}}
+],"total":4}`
+
+func TestTagResolver(t *testing.T) {
+	fetches := 0
+	dex := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/"+MangaTagPath {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		fetches++
+		_, _ = w.Write([]byte(tagCatalogue))
+	}))
+	ctx := context.Background()
+	tags := NewTagResolver(dex)
+
+	ids, err := tags.Resolve(ctx, "action", "アクション", " LONG STRIP ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 3 || ids[0] != ids[1] || ids[2] != "33333333-3333-3333-3333-333333333333" {
+		t.Errorf("unexpected IDs %v", ids)
+	}
+
+	_, err = tags.Resolve(ctx, "Romance", "Isekai")
+	var nameErr *TagNameError
+	if !errors.As(err, &nameErr) {
+		t.Fatalf("expected *TagNameError, got %v", err)
+	}
+	if len(nameErr.Unknown) != 1 || nameErr.Unknown[0] != "Isekai" || len(nameErr.Ambiguous["Romance"]) != 2 {
+		t.Errorf("unexpected error %+v", nameErr)
+	}
+
+	groups, err := tags.TagsByGroup(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups[GenreTagGroup]) != 2 || len(groups[FormatTagGroup]) != 1 || len(groups[ContentTagGroup]) != 1 {
+		t.Errorf("unexpected groups %v", groups)
+	}
+	if fetches != 1 {
+		t.Errorf("expected the catalogue to be fetched once, got %d", fetches)
+	}
+
+	q := &MangaSearchQuery{IncludedTagNames: []string{"Action"}, ExcludedTagNames: []string{"gore"}}
+	if _, err = q.Values(); err == nil {
+		t.Error("expected unresolved tag names to fail validation")
+	}
+	if err = q.ResolveTags(ctx, tags); err != nil {
+		t.Fatal(err)
+	}
+	v, err := q.Values()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Get("includedTags[]") != "11111111-1111-1111-1111-111111111111" ||
+		v.Get("excludedTags[]") != "44444444-4444-4444-4444-444444444444" {
+		t.Errorf("unexpected values %s", v.Encode())
+	}
+}
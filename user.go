@@ -9,6 +9,7 @@ import (
 
 const (
 	GetUserFollowedMangaListPath = "user/follows/manga"
+	GetUserFollowedMangaFeedPath = "user/follows/manga/feed"
 	GetLoggedUserPath            = "user/me"
 )
 
@@ -23,8 +24,7 @@ func (s *UserService) GetUserFollowedMangaList(limit, offset int, includes []str
 
 // GetUserFollowedMangaListContext : GetUserFollowedMangaListPath with custom context.
 func (s *UserService) GetUserFollowedMangaListContext(ctx context.Context, limit, offset int, includes []string) (*MangaList, error) {
-	u, _ := url.Parse(BaseAPI)
-	u.Path = GetUserFollowedMangaListPath
+	u := s.client.buildURL(GetUserFollowedMangaListPath)
 
 	// Set required query parameters
 	q := u.Query()
@@ -40,6 +40,55 @@ func (s *UserService) GetUserFollowedMangaListContext(ctx context.Context, limit
 	return &l, err
 }
 
+// IterateUserFollowedMangaList : Get a MangaIterator over all followed Manga.
+// Pages are fetched lazily using GetUserFollowedMangaListContext.
+// Deep scans are not supported by this endpoint, and fail with ErrDeepScanUnsupported.
+func (s *UserService) IterateUserFollowedMangaList(includes []string) *MangaIterator {
+	it := newMangaIterator(nil, func(ctx context.Context, params url.Values) (*MangaList, error) {
+		limit, _ := strconv.Atoi(params.Get("limit"))
+		offset, _ := strconv.Atoi(params.Get("offset"))
+		return s.GetUserFollowedMangaListContext(ctx, limit, offset, includes)
+	})
+	it.noDeepScan = true
+	return it
+}
+
+// GetFollowedMangaFeed : Get the chapter feed of all followed Manga, filtered by a feed query.
+// A nil query returns the unfiltered feed.
+// https://api.mangadex.org/docs.html#operation/get-user-follows-manga-feed
+func (s *UserService) GetFollowedMangaFeed(q *ChapterFeedQuery) (*ChapterList, error) {
+	return s.GetFollowedMangaFeedContext(context.Background(), q)
+}
+
+// GetFollowedMangaFeedContext : GetFollowedMangaFeed with custom context.
+func (s *UserService) GetFollowedMangaFeedContext(ctx context.Context, q *ChapterFeedQuery) (*ChapterList, error) {
+	params, err := q.Values()
+	if err != nil {
+		return nil, err
+	}
+	return s.getFollowedMangaFeed(ctx, params)
+}
+
+// IterateFollowedMangaFeed : Get a ChapterIterator over the chapter feed of all followed Manga matching a feed query.
+// Pages are fetched lazily. A nil query iterates the unfiltered feed.
+// An invalid query is reported by Err after the first call to Next.
+func (s *UserService) IterateFollowedMangaFeed(q *ChapterFeedQuery) *ChapterIterator {
+	params, err := q.Values()
+	it := newChapterIterator(params, s.getFollowedMangaFeed)
+	it.err = err
+	return it
+}
+
+// getFollowedMangaFeed : Get a page of the followed Manga chapter feed.
+func (s *UserService) getFollowedMangaFeed(ctx context.Context, params url.Values) (*ChapterList, error) {
+	u := s.client.buildURL(GetUserFollowedMangaFeedPath)
+	u.RawQuery = params.Encode()
+
+	var l ChapterList
+	err := s.client.RequestAndDecode(ctx, http.MethodGet, u.String(), nil, &l)
+	return &l, err
+}
+
 // UserResponse : Typical User response.
 type UserResponse struct {
 	Result   string `json:"result"`
@@ -74,8 +123,7 @@ func (s *UserService) GetLoggedUser() (*UserResponse, error) {
 
 // GetLoggedUserContext : GetLoggedUser with custom context.
 func (s *UserService) GetLoggedUserContext(ctx context.Context) (*UserResponse, error) {
-	u, _ := url.Parse(BaseAPI)
-	u.Path = GetLoggedUserPath
+	u := s.client.buildURL(GetLoggedUserPath)
 
 	var r UserResponse
 	err := s.client.RequestAndDecode(ctx, http.MethodGet, u.String(), nil, &r)
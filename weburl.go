@@ -0,0 +1,170 @@
+package mangodex
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	WebURL = "https://mangadex.org"
+)
+
+// webPaths : Path segment of the website for each relationship type.
+var webPaths = map[string]string{
+	MangaRel:           "title",
+	ChapterRel:         "chapter",
+	ScanlationGroupRel: "group",
+	AuthorRel:          "author",
+	UserRel:            "user",
+	CustomListRel:      "list",
+}
+
+// legacyTypes : Legacy mapping type for each relationship type that has legacy IDs.
+var legacyTypes = map[string]string{
+	MangaRel:           LegacyMangaType,
+	ChapterRel:         LegacyChapterType,
+	ScanlationGroupRel: LegacyGroupType,
+}
+
+// EntityRef : Reference to an entity parsed from a MangaDex website URL.
+type EntityRef struct {
+	// Type : Relationship type of the entity, such as MangaRel or ChapterRel.
+	Type string
+	// ID : UUID of the entity. Empty for legacy URLs until resolved with ResolveLegacy.
+	ID string
+	// LegacyID : Numeric ID of the entity for legacy URLs, or 0.
+	LegacyID int
+	// Page : Page number for chapter URLs, or 0 if none was given.
+	Page int
+}
+
+// ParseURL : Parse a MangaDex website URL, such as https://mangadex.org/title/<uuid>/slug,
+// into an EntityRef. Old numeric URLs, such as https://mangadex.org/title/12345, set LegacyID instead of ID.
+func ParseURL(raw string) (*EntityRef, error) {
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, err
+	}
+	if host := strings.TrimPrefix(u.Hostname(), "www."); host != "mangadex.org" {
+		return nil, fmt.Errorf("%s is not a MangaDex URL", raw)
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(segments) < 2 {
+		return nil, fmt.Errorf("%s does not link to an entity", raw)
+	}
+
+	ref := &EntityRef{}
+	switch segments[0] {
+	case "manga": // Old URLs use /manga/ instead of /title/.
+		ref.Type = MangaRel
+	default:
+		for typ, p := range webPaths {
+			if p == segments[0] {
+				ref.Type = typ
+			}
+		}
+	}
+	if ref.Type == "" {
+		return nil, fmt.Errorf("%s links to an unsupported page %q", raw, segments[0])
+	}
+
+	if uuidRegex.MatchString(segments[1]) {
+		ref.ID = strings.ToLower(segments[1])
+	} else if id, err := strconv.Atoi(segments[1]); err == nil && id > 0 && legacyTypes[ref.Type] != "" {
+		ref.LegacyID = id
+	} else {
+		return nil, fmt.Errorf("%s has an invalid %s ID %q", raw, ref.Type, segments[1])
+	}
+
+	if ref.Type == ChapterRel && len(segments) > 2 {
+		page, err := strconv.Atoi(segments[2])
+		if err != nil || page < 1 {
+			return nil, fmt.Errorf("%s has an invalid page %q", raw, segments[2])
+		}
+		ref.Page = page
+	}
+	return ref, nil
+}
+
+// IsLegacy : Check if the reference only has a legacy numeric ID.
+func (r *EntityRef) IsLegacy() bool {
+	return r.ID == "" && r.LegacyID != 0
+}
+
+// ResolveLegacy : Set the ID of a legacy reference using the legacy mapping cache.
+// Does nothing if the reference already has an ID.
+func (r *EntityRef) ResolveLegacy(ctx context.Context, cache *LegacyCache) error {
+	if !r.IsLegacy() {
+		return nil
+	}
+	typ, ok := legacyTypes[r.Type]
+	if !ok {
+		return fmt.Errorf("%s has no legacy IDs", r.Type)
+	}
+
+	uuids, err := cache.Resolve(ctx, typ, []int{r.LegacyID})
+	if err != nil {
+		return err
+	}
+	uuid, ok := uuids[r.LegacyID]
+	if !ok {
+		return fmt.Errorf("no %s found for legacy ID %d", r.Type, r.LegacyID)
+	}
+	r.ID = uuid
+	return nil
+}
+
+// WebURL : Get the website URL of the referenced entity, including the page for chapters.
+func (r *EntityRef) WebURL() (string, error) {
+	if r.IsLegacy() {
+		return "", fmt.Errorf("legacy %s %d must be resolved first", r.Type, r.LegacyID)
+	}
+	u, err := BuildWebURL(r.Type, r.ID)
+	if err != nil {
+		return "", err
+	}
+	if r.Type == ChapterRel && r.Page > 0 {
+		u += "/" + strconv.Itoa(r.Page)
+	}
+	return u, nil
+}
+
+// BuildWebURL : Get the website URL of an entity, given its relationship type, such as MangaRel, and ID.
+func BuildWebURL(typ, id string) (string, error) {
+	p, ok := webPaths[typ]
+	if !ok {
+		return "", fmt.Errorf("no web page for %s", typ)
+	}
+	return fmt.Sprintf("%s/%s/%s", WebURL, p, id), nil
+}
+
+// WebURL : Get the website URL of the Manga.
+func (m *Manga) WebURL() string {
+	u, _ := BuildWebURL(MangaRel, m.ID)
+	return u
+}
+
+// WebURL : Get the website URL of the Chapter.
+func (c *Chapter) WebURL() string {
+	u, _ := BuildWebURL(ChapterRel, c.ID)
+	return u
+}
+
+// WebURL : Get the website URL of the ScanlationGroup.
+func (g *ScanlationGroup) WebURL() string {
+	u, _ := BuildWebURL(ScanlationGroupRel, g.ID)
+	return u
+}
+
+// WebURL : Get the website URL of the Author.
+func (a *Author) WebURL() string {
+	u, _ := BuildWebURL(AuthorRel, a.ID)
+	return u
+}
@@ -0,0 +1,77 @@
+package mangodex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestParseURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want EntityRef
+	}{
+		{"https://mangadex.org/title/" + testUUID + "/some-slug", EntityRef{Type: MangaRel, ID: testUUID}},
+		{"mangadex.org/chapter/" + testUUID + "/3", EntityRef{Type: ChapterRel, ID: testUUID, Page: 3}},
+		{"https://www.mangadex.org/group/" + testUUID, EntityRef{Type: ScanlationGroupRel, ID: testUUID}},
+		{"https://mangadex.org/author/" + testUUID + "/name", EntityRef{Type: AuthorRel, ID: testUUID}},
+		{"https://mangadex.org/title/12345", EntityRef{Type: MangaRel, LegacyID: 12345}},
+		{"https://mangadex.org/manga/12345/slug", EntityRef{Type: MangaRel, LegacyID: 12345}},
+	}
+	for _, tt := range tests {
+		ref, err := ParseURL(tt.url)
+		if err != nil {
+			t.Errorf("%s: %v", tt.url, err)
+			continue
+		}
+		if *ref != tt.want {
+			t.Errorf("%s: expected %+v, got %+v", tt.url, tt.want, *ref)
+		}
+	}
+
+	for _, bad := range []string{
+		"https://example.com/title/" + testUUID,
+		"https://mangadex.org/title",
+		"https://mangadex.org/forums/12345",
+		"https://mangadex.org/author/12345",
+		"https://mangadex.org/chapter/" + testUUID + "/first",
+	} {
+		if _, err := ParseURL(bad); err == nil {
+			t.Errorf("%s: expected an error", bad)
+		}
+	}
+}
+
+func TestBuildWebURL(t *testing.T) {
+	ref := &EntityRef{Type: ChapterRel, ID: testUUID, Page: 3}
+	if u, err := ref.WebURL(); err != nil || u != WebURL+"/chapter/"+testUUID+"/3" {
+		t.Errorf("unexpected URL %s: %v", u, err)
+	}
+	m := &Manga{ID: testUUID}
+	if ref, err := ParseURL(m.WebURL()); err != nil || ref.Type != MangaRel || ref.ID != testUUID {
+		t.Errorf("unexpected round trip %+v: %v", ref, err)
+	}
+	if _, err := BuildWebURL(TagRel, testUUID); err == nil {
+		t.Error("expected tags to have no web page")
+	}
+}
+
+func TestResolveLegacyURL(t *testing.T) {
+	dex := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(&LegacyMappingList{Result: "ok", Data: []LegacyMapping{
+			{Attributes: LegacyMappingAttributes{Type: LegacyMangaType, LegacyID: 12345, NewID: testUUID}},
+		}})
+	}))
+
+	ref, err := ParseURL("https://mangadex.org/title/12345")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ref.ResolveLegacy(context.Background(), NewLegacyCache(dex)); err != nil {
+		t.Fatal(err)
+	}
+	if ref.IsLegacy() || ref.ID != testUUID {
+		t.Errorf("unexpected reference %+v", ref)
+	}
+}